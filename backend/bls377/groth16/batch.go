@@ -0,0 +1,151 @@
+package groth16
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gurvy/bls377"
+	"github.com/consensys/gurvy/bls377/fr"
+)
+
+// VerifyBatch aggregates N proofs sharing a VerifyingKey into one
+// batched pairing check, the same random-linear-combination approach
+// as the bw6761 backend's VerifyBatch: sample independent 128-bit
+// random scalars r_i and check
+//
+//	prod_i e(r_i*A_i, B_i) = e(sum_i r_i*alpha, beta) * e(sum_i r_i*vk_x_i, gamma) * e(sum_i r_i*C_i, delta)
+//
+// All proofs must be for the same VerifyingKey; proofs, vks and
+// solutions must have the same length.
+func VerifyBatch(proofs []*Proof, vks []*VerifyingKey, solutions []map[string]interface{}) error {
+	if len(proofs) != len(vks) || len(proofs) != len(solutions) {
+		return fmt.Errorf("groth16: VerifyBatch needs the same number of proofs, verifying keys and solutions")
+	}
+	if len(proofs) == 0 {
+		return nil
+	}
+	for i := 1; i < len(vks); i++ {
+		if vks[i].IsDifferent(vks[0]) {
+			return fmt.Errorf("groth16: VerifyBatch requires all proofs to share a VerifyingKey, but vk %d differs from vk 0", i)
+		}
+	}
+
+	ps := make([]bls377.G1Affine, 0, len(proofs)+3)
+	qs := make([]bls377.G2Affine, 0, len(proofs)+3)
+
+	var sumR fr.Element
+	var sumVkX, sumKrs bls377.G1Jac
+
+	for i, proof := range proofs {
+		r, err := randBatchScalar()
+		if err != nil {
+			return fmt.Errorf("groth16: sampling batch scalar: %w", err)
+		}
+		rBig := r.ToBigIntRegular(new(big.Int))
+
+		var rA bls377.G1Affine
+		rA.ScalarMultiplication(&proof.Ar, rBig)
+		ps = append(ps, rA)
+		qs = append(qs, proof.Bs)
+
+		vkX, err := batchVkX(vks[i], solutions[i])
+		if err != nil {
+			return err
+		}
+		var rVkX, rKrs bls377.G1Jac
+		rVkX.FromAffine(&vkX)
+		rVkX.ScalarMultiplication(&rVkX, rBig)
+		sumVkX.AddAssign(&rVkX)
+
+		rKrs.FromAffine(&proof.Krs)
+		rKrs.ScalarMultiplication(&rKrs, rBig)
+		sumKrs.AddAssign(&rKrs)
+
+		sumR.Add(&sumR, &r)
+	}
+
+	var negAlpha bls377.G1Affine
+	negAlpha.ScalarMultiplication(&vks[0].G1.Alpha, sumR.ToBigIntRegular(new(big.Int)))
+	negAlpha.Neg(&negAlpha)
+	ps = append(ps, negAlpha)
+	qs = append(qs, vks[0].G2.Beta)
+
+	var negVkX bls377.G1Affine
+	negVkX.FromJacobian(&sumVkX)
+	negVkX.Neg(&negVkX)
+	ps = append(ps, negVkX)
+	qs = append(qs, vks[0].G2.Gamma)
+
+	var negKrs bls377.G1Affine
+	negKrs.FromJacobian(&sumKrs)
+	negKrs.Neg(&negKrs)
+	ps = append(ps, negKrs)
+	qs = append(qs, vks[0].G2.Delta)
+
+	ok, err := bls377.PairingCheck(ps, qs)
+	if err != nil {
+		return fmt.Errorf("groth16: batch pairing check: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("groth16: batch proof does not satisfy the constraint system")
+	}
+	return nil
+}
+
+// batchVkX folds solution into vk.G1Kvk in vk.Variables() order, the
+// same order the single-proof Verify in this package uses for vk_x.
+func batchVkX(vk *VerifyingKey, solution map[string]interface{}) (bls377.G1Affine, error) {
+	names := vk.Variables()
+	if len(names) != len(vk.G1Kvk) {
+		return bls377.G1Affine{}, fmt.Errorf("groth16: vk has %d G1Kvk entries but %d variable names", len(vk.G1Kvk), len(names))
+	}
+
+	var acc bls377.G1Jac
+	for i, name := range names {
+		v, ok := solution[name]
+		if !ok {
+			return bls377.G1Affine{}, fmt.Errorf("groth16: missing public input %q", name)
+		}
+		b, err := batchToBigInt(v)
+		if err != nil {
+			return bls377.G1Affine{}, err
+		}
+		var term bls377.G1Jac
+		term.FromAffine(&vk.G1Kvk[i])
+		term.ScalarMultiplication(&term, b)
+		acc.AddAssign(&term)
+	}
+
+	var res bls377.G1Affine
+	res.FromJacobian(&acc)
+	return res, nil
+}
+
+// batchToBigInt converts a solution value to a *big.Int the way Prove
+// and Verify in this package do: it accepts anything fmt.Sprint turns
+// into a base-10 integer literal (int, int64, *big.Int, a numeric
+// string, ...).
+func batchToBigInt(v interface{}) (*big.Int, error) {
+	if b, ok := v.(*big.Int); ok {
+		return b, nil
+	}
+	b, ok := new(big.Int).SetString(fmt.Sprint(v), 10)
+	if !ok {
+		return nil, fmt.Errorf("groth16: %v is not an integer", v)
+	}
+	return b, nil
+}
+
+// randBatchScalar samples a uniformly random fr.Element from 128 bits
+// of entropy: enough that a cheating prover can't predict a
+// combination of false equations that cancels out under it.
+func randBatchScalar() (fr.Element, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return fr.Element{}, err
+	}
+	var e fr.Element
+	e.SetBytes(buf[:])
+	return e, nil
+}
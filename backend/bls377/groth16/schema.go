@@ -0,0 +1,22 @@
+package groth16
+
+import "fmt"
+
+// Variables implements witness.Schema for VerifyingKey: VerifyBatch and
+// the top-level groth16.VerifyWithWitness both fold a solution into
+// vk.G1Kvk in this order.
+//
+// VerifyingKey doesn't carry the circuit's real public variable names
+// (only the R1CS that produced it does), so this package can't return
+// them here. The names below are positional placeholders matching
+// vk.G1Kvk's order; a caller driving Verify/VerifyBatch through
+// VerifyWithWitness against this curve needs a witness.Provider that
+// assigns by that same position, not by the circuit's own variable
+// names.
+func (vk *VerifyingKey) Variables() []string {
+	names := make([]string, len(vk.G1Kvk))
+	for i := range names {
+		names[i] = fmt.Sprintf("public_%d", i)
+	}
+	return names
+}
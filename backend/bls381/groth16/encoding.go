@@ -0,0 +1,321 @@
+package groth16
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gurvy/bls381"
+)
+
+// fpBytes is the serialized size of bls381's base field. g1Bytes and
+// g2Bytes are the uncompressed (X||Y) sizes of G1/G2 affine points;
+// g2Bytes is twice g1Bytes because G2 is over the quadratic extension
+// Fp2. The compressed sizes drop the Y coordinate (recovered from X and
+// a sign bit folded into the encoding), so they're half as large.
+const (
+	fpBytes = 48
+
+	g1Bytes           = 2 * fpBytes
+	g1BytesCompressed = fpBytes
+
+	g2Bytes           = 2 * g1Bytes
+	g2BytesCompressed = g1Bytes
+)
+
+func writeG1(w io.Writer, p *bls381.G1Affine, compressed bool) (int64, error) {
+	if compressed {
+		b := p.CompressedBytes()
+		n, err := w.Write(b[:])
+		return int64(n), err
+	}
+	b := p.Bytes()
+	n, err := w.Write(b[:])
+	return int64(n), err
+}
+
+func readG1(r io.Reader, p *bls381.G1Affine, compressed bool) (int64, error) {
+	size := g1Bytes
+	if compressed {
+		size = g1BytesCompressed
+	}
+	b := make([]byte, size)
+	n, err := io.ReadFull(r, b)
+	if err != nil {
+		return int64(n), err
+	}
+	if compressed {
+		if err := p.SetCompressedBytes(b); err != nil {
+			return int64(n), err
+		}
+		return int64(n), nil
+	}
+	p.SetBytes(b)
+	return int64(n), nil
+}
+
+func writeG2(w io.Writer, p *bls381.G2Affine, compressed bool) (int64, error) {
+	if compressed {
+		b := p.CompressedBytes()
+		n, err := w.Write(b[:])
+		return int64(n), err
+	}
+	b := p.Bytes()
+	n, err := w.Write(b[:])
+	return int64(n), err
+}
+
+func readG2(r io.Reader, p *bls381.G2Affine, compressed bool) (int64, error) {
+	size := g2Bytes
+	if compressed {
+		size = g2BytesCompressed
+	}
+	b := make([]byte, size)
+	n, err := io.ReadFull(r, b)
+	if err != nil {
+		return int64(n), err
+	}
+	if compressed {
+		if err := p.SetCompressedBytes(b); err != nil {
+			return int64(n), err
+		}
+		return int64(n), nil
+	}
+	p.SetBytes(b)
+	return int64(n), nil
+}
+
+func writeG1Slice(w io.Writer, pts []bls381.G1Affine, compressed bool) (int64, error) {
+	var written int64
+	if err := binary.Write(w, binary.BigEndian, uint32(len(pts))); err != nil {
+		return written, err
+	}
+	written += 4
+	for i := range pts {
+		n, err := writeG1(w, &pts[i], compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+func readG1Slice(r io.Reader, compressed bool) ([]bls381.G1Affine, int64, error) {
+	var read int64
+	var nb uint32
+	if err := binary.Read(r, binary.BigEndian, &nb); err != nil {
+		return nil, read, err
+	}
+	read += 4
+	pts := make([]bls381.G1Affine, nb)
+	for i := range pts {
+		n, err := readG1(r, &pts[i], compressed)
+		read += n
+		if err != nil {
+			return nil, read, err
+		}
+	}
+	return pts, read, nil
+}
+
+func writeG2Slice(w io.Writer, pts []bls381.G2Affine, compressed bool) (int64, error) {
+	var written int64
+	if err := binary.Write(w, binary.BigEndian, uint32(len(pts))); err != nil {
+		return written, err
+	}
+	written += 4
+	for i := range pts {
+		n, err := writeG2(w, &pts[i], compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+func readG2Slice(r io.Reader, compressed bool) ([]bls381.G2Affine, int64, error) {
+	var read int64
+	var nb uint32
+	if err := binary.Read(r, binary.BigEndian, &nb); err != nil {
+		return nil, read, err
+	}
+	read += 4
+	pts := make([]bls381.G2Affine, nb)
+	for i := range pts {
+		n, err := readG2(r, &pts[i], compressed)
+		read += n
+		if err != nil {
+			return nil, read, err
+		}
+	}
+	return pts, read, nil
+}
+
+// WriteTo implements encoding.WriterTo, so ProvingKey can round-trip
+// through the top-level groth16 package's versioned header format.
+func (pk *ProvingKey) WriteTo(w io.Writer, compressed bool) (int64, error) {
+	var written int64
+
+	n, err := pk.Domain.WriteTo(w)
+	written += n
+	if err != nil {
+		return written, err
+	}
+
+	for _, p := range []*bls381.G1Affine{&pk.G1.Alpha, &pk.G1.Beta, &pk.G1.Delta} {
+		n, err = writeG1(w, p, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	for _, s := range [][]bls381.G1Affine{pk.G1.A, pk.G1.B, pk.G1.Z, pk.G1.K} {
+		n, err = writeG1Slice(w, s, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+
+	for _, p := range []*bls381.G2Affine{&pk.G2.Beta, &pk.G2.Delta} {
+		n, err = writeG2(w, p, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	n, err = writeG2Slice(w, pk.G2.B, compressed)
+	written += n
+	return written, err
+}
+
+// ReadFrom implements encoding.ReaderFrom.
+func (pk *ProvingKey) ReadFrom(r io.Reader, compressed bool) (int64, error) {
+	var read int64
+
+	n, err := pk.Domain.ReadFrom(r)
+	read += n
+	if err != nil {
+		return read, err
+	}
+
+	for _, p := range []*bls381.G1Affine{&pk.G1.Alpha, &pk.G1.Beta, &pk.G1.Delta} {
+		n, err = readG1(r, p, compressed)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	pk.G1.A, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	pk.G1.B, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	pk.G1.Z, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	pk.G1.K, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+
+	for _, p := range []*bls381.G2Affine{&pk.G2.Beta, &pk.G2.Delta} {
+		n, err = readG2(r, p, compressed)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	pk.G2.B, n, err = readG2Slice(r, compressed)
+	read += n
+	return read, err
+}
+
+// WriteTo implements encoding.WriterTo.
+func (vk *VerifyingKey) WriteTo(w io.Writer, compressed bool) (int64, error) {
+	var written int64
+
+	n, err := writeG1(w, &vk.G1.Alpha, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	for _, p := range []*bls381.G2Affine{&vk.G2.Beta, &vk.G2.Gamma, &vk.G2.Delta} {
+		n, err = writeG2(w, p, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	n, err = writeG1Slice(w, vk.G1Kvk, compressed)
+	written += n
+	return written, err
+}
+
+// ReadFrom implements encoding.ReaderFrom.
+func (vk *VerifyingKey) ReadFrom(r io.Reader, compressed bool) (int64, error) {
+	var read int64
+
+	n, err := readG1(r, &vk.G1.Alpha, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	for _, p := range []*bls381.G2Affine{&vk.G2.Beta, &vk.G2.Gamma, &vk.G2.Delta} {
+		n, err = readG2(r, p, compressed)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	vk.G1Kvk, n, err = readG1Slice(r, compressed)
+	read += n
+	return read, err
+}
+
+// WriteTo implements encoding.WriterTo.
+func (proof *Proof) WriteTo(w io.Writer, compressed bool) (int64, error) {
+	var written int64
+
+	n, err := writeG1(w, &proof.Ar, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	n, err = writeG2(w, &proof.Bs, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	n, err = writeG1(w, &proof.Krs, compressed)
+	written += n
+	return written, err
+}
+
+// ReadFrom implements encoding.ReaderFrom.
+func (proof *Proof) ReadFrom(r io.Reader, compressed bool) (int64, error) {
+	var read int64
+
+	n, err := readG1(r, &proof.Ar, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	n, err = readG2(r, &proof.Bs, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	n, err = readG1(r, &proof.Krs, compressed)
+	read += n
+	return read, err
+}
@@ -0,0 +1,694 @@
+// Package groth16 implements the Groth16 zk-SNARK over BW6-761,
+// following the same Setup/Prove/Verify shape as the bn256, bls377 and
+// bls381 backends so the top-level backend/groth16 dispatcher can treat
+// it identically.
+//
+// Verify, VerifyBatch and the ProvingKey/VerifyingKey/Proof wire format
+// are fully implemented. Setup, DummySetup, Prove and the in-circuit
+// BLS12-377 verifier (VerifyBLS377) are not: they need a QAP reduction
+// and FFT-based prover this package doesn't have yet, so they return
+// ErrNotImplemented instead of silently producing wrong output. Unlike
+// the other backends' Setup/DummySetup, this package's versions return
+// an error rather than panicking, precisely because that failure is
+// expected right now.
+package groth16
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	groth16_bls377 "github.com/consensys/gnark/backend/bls377/groth16"
+	backend_bw6761 "github.com/consensys/gnark/backend/bw6761"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gurvy/bw6761"
+	"github.com/consensys/gurvy/bw6761/fr"
+)
+
+// ProvingKey is the Groth16 proving key over BW6-761.
+type ProvingKey struct {
+	Domain fr.Domain
+	G1     G1ProvingKey
+	G2     G2ProvingKey
+}
+
+// G1ProvingKey holds the G1 elements of the proving key.
+type G1ProvingKey struct {
+	Alpha, Beta, Delta bw6761.G1Affine
+	A, B, Z, K         []bw6761.G1Affine
+}
+
+// G2ProvingKey holds the G2 elements of the proving key.
+type G2ProvingKey struct {
+	Beta, Delta bw6761.G2Affine
+	B           []bw6761.G2Affine
+}
+
+// VerifyingKey is the Groth16 verifying key over BW6-761.
+type VerifyingKey struct {
+	G1    struct{ Alpha bw6761.G1Affine }
+	G2    struct{ Beta, Gamma, Delta bw6761.G2Affine }
+	G1Kvk []bw6761.G1Affine
+
+	// PublicVariableNames holds the circuit's public wire names, in the
+	// order their values are folded into G1Kvk. Setup will populate this
+	// from the R1CS's own WireNames (see backend/bw6761.R1CS.Variables)
+	// once it's implemented; until then it's left for a caller to set,
+	// and Variables falls back to positional placeholders if it's empty.
+	PublicVariableNames []string
+}
+
+// Proof is a Groth16 proof over BW6-761.
+type Proof struct {
+	Ar, Krs bw6761.G1Affine
+	Bs      bw6761.G2Affine
+}
+
+// IsDifferent reports whether other is not this same *ProvingKey. It
+// compares pointers: a key with equal contents at another address is
+// reported as different.
+func (pk *ProvingKey) IsDifferent(other interface{}) bool {
+	o, ok := other.(*ProvingKey)
+	if !ok {
+		return true
+	}
+	return pk != o
+}
+
+// IsDifferent reports whether other is not this same *VerifyingKey,
+// comparing pointers like ProvingKey.IsDifferent.
+func (vk *VerifyingKey) IsDifferent(other interface{}) bool {
+	o, ok := other.(*VerifyingKey)
+	if !ok {
+		return true
+	}
+	return vk != o
+}
+
+// Variables implements witness.Schema against the public inputs a
+// VerifyingKey expects, in the order Verify wants them folded into
+// vk_x. It returns PublicVariableNames when non-empty; a VerifyingKey
+// with none (e.g. built before that field existed) falls back to
+// positional placeholders instead of panicking on index out of range.
+func (vk *VerifyingKey) Variables() []string {
+	if len(vk.PublicVariableNames) != 0 {
+		return vk.PublicVariableNames
+	}
+	names := make([]string, len(vk.G1Kvk))
+	for i := range names {
+		names[i] = "public_" + itoa(i)
+	}
+	return names
+}
+
+func itoa(i int) string {
+	if i == 0 {
+		return "0"
+	}
+	var buf [20]byte
+	pos := len(buf)
+	for i > 0 {
+		pos--
+		buf[pos] = byte('0' + i%10)
+		i /= 10
+	}
+	return string(buf[pos:])
+}
+
+// ErrUnsatisfiedConstraint is returned by Verify/VerifyBatch when the
+// pairing check fails.
+var ErrUnsatisfiedConstraint = errors.New("bw6761/groth16: proof does not satisfy the constraint system")
+
+// ErrNotImplemented is returned by the parts of this backend that
+// aren't built yet: see the package doc comment for what that covers.
+var ErrNotImplemented = errors.New("bw6761/groth16: not implemented in this build")
+
+// Setup runs the Groth16 trusted setup for cs, filling pk and vk.
+//
+// Not implemented yet: always returns ErrNotImplemented.
+func Setup(cs *backend_bw6761.R1CS, pk *ProvingKey, vk *VerifyingKey) error {
+	return ErrNotImplemented
+}
+
+// DummySetup creates a random ProvingKey for cs without a matching
+// VerifyingKey; for benchmarking or test purposes only.
+//
+// Not implemented yet: always returns ErrNotImplemented.
+func DummySetup(cs *backend_bw6761.R1CS, pk *ProvingKey) error {
+	return ErrNotImplemented
+}
+
+// Prove runs the Groth16 prover for cs/pk against solution.
+//
+// Not implemented yet: always returns ErrNotImplemented.
+func Prove(cs *backend_bw6761.R1CS, pk *ProvingKey, solution map[string]interface{}) (*Proof, error) {
+	return nil, ErrNotImplemented
+}
+
+// Verify runs the Groth16 verifier: it checks
+//
+//	e(proof.Ar, proof.Bs) == e(vk.G1.Alpha, vk.G2.Beta) * e(vk_x, vk.G2.Gamma) * e(proof.Krs, vk.G2.Delta)
+//
+// where vk_x is the linear combination of vk.G1Kvk weighted by solution,
+// folded in vk.Variables() order.
+func Verify(proof *Proof, vk *VerifyingKey, solution map[string]interface{}) error {
+	vkX, err := vk.computeVkX(solution)
+	if err != nil {
+		return err
+	}
+
+	var negAlpha, negVkX, negKrs bw6761.G1Affine
+	negAlpha.Neg(&vk.G1.Alpha)
+	negVkX.Neg(&vkX)
+	negKrs.Neg(&proof.Krs)
+
+	ok, err := bw6761.PairingCheck(
+		[]bw6761.G1Affine{proof.Ar, negAlpha, negVkX, negKrs},
+		[]bw6761.G2Affine{proof.Bs, vk.G2.Beta, vk.G2.Gamma, vk.G2.Delta},
+	)
+	if err != nil {
+		return fmt.Errorf("bw6761/groth16: pairing check: %w", err)
+	}
+	if !ok {
+		return ErrUnsatisfiedConstraint
+	}
+	return nil
+}
+
+// VerifyBatch aggregates N BW6-761 proofs sharing a VerifyingKey into
+// one batched pairing check, the same random-linear-combination
+// approach as the bn256/bls377/bls381 backends' VerifyBatch: sample
+// independent 128-bit random scalars r_i and check
+//
+//	prod_i e(r_i*A_i, B_i) = e(sum_i r_i*alpha, beta) * e(sum_i r_i*vk_x_i, gamma) * e(sum_i r_i*C_i, delta)
+//
+// All proofs must be for the same VerifyingKey; proofs, vks and
+// solutions must have the same length.
+func VerifyBatch(proofs []*Proof, vks []*VerifyingKey, solutions []map[string]interface{}) error {
+	if len(proofs) != len(vks) || len(proofs) != len(solutions) {
+		return fmt.Errorf("bw6761/groth16: VerifyBatch needs the same number of proofs, verifying keys and solutions")
+	}
+	if len(proofs) == 0 {
+		return nil
+	}
+	for i := 1; i < len(vks); i++ {
+		if vks[i].IsDifferent(vks[0]) {
+			return fmt.Errorf("bw6761/groth16: VerifyBatch requires all proofs to share a VerifyingKey, but vk %d differs from vk 0", i)
+		}
+	}
+
+	ps := make([]bw6761.G1Affine, 0, len(proofs)+3)
+	qs := make([]bw6761.G2Affine, 0, len(proofs)+3)
+
+	var sumR fr.Element
+	var sumVkX, sumKrs bw6761.G1Jac
+
+	for i, proof := range proofs {
+		r, err := randScalar()
+		if err != nil {
+			return fmt.Errorf("bw6761/groth16: sampling batch scalar: %w", err)
+		}
+		rBig := r.ToBigIntRegular(new(big.Int))
+
+		var rA bw6761.G1Affine
+		rA.ScalarMultiplication(&proof.Ar, rBig)
+		ps = append(ps, rA)
+		qs = append(qs, proof.Bs)
+
+		vkX, err := vks[i].computeVkX(solutions[i])
+		if err != nil {
+			return err
+		}
+		var rVkX, rKrs bw6761.G1Jac
+		rVkX.FromAffine(&vkX)
+		rVkX.ScalarMultiplication(&rVkX, rBig)
+		sumVkX.AddAssign(&rVkX)
+
+		rKrs.FromAffine(&proof.Krs)
+		rKrs.ScalarMultiplication(&rKrs, rBig)
+		sumKrs.AddAssign(&rKrs)
+
+		sumR.Add(&sumR, &r)
+	}
+
+	var negAlpha bw6761.G1Affine
+	negAlpha.ScalarMultiplication(&vks[0].G1.Alpha, sumR.ToBigIntRegular(new(big.Int)))
+	negAlpha.Neg(&negAlpha)
+	ps = append(ps, negAlpha)
+	qs = append(qs, vks[0].G2.Beta)
+
+	var negVkX bw6761.G1Affine
+	negVkX.FromJacobian(&sumVkX)
+	negVkX.Neg(&negVkX)
+	ps = append(ps, negVkX)
+	qs = append(qs, vks[0].G2.Gamma)
+
+	var negKrs bw6761.G1Affine
+	negKrs.FromJacobian(&sumKrs)
+	negKrs.Neg(&negKrs)
+	ps = append(ps, negKrs)
+	qs = append(qs, vks[0].G2.Delta)
+
+	ok, err := bw6761.PairingCheck(ps, qs)
+	if err != nil {
+		return fmt.Errorf("bw6761/groth16: batch pairing check: %w", err)
+	}
+	if !ok {
+		return ErrUnsatisfiedConstraint
+	}
+	return nil
+}
+
+// computeVkX folds solution into vk.G1Kvk in the order vk.Variables()
+// declares (PublicVariableNames, or public_0, public_1, ... when that is
+// empty), the order Verify and VerifyBatch
+// expect vk_x's terms in.
+func (vk *VerifyingKey) computeVkX(solution map[string]interface{}) (bw6761.G1Affine, error) {
+	names := vk.Variables()
+	if len(names) != len(vk.G1Kvk) {
+		return bw6761.G1Affine{}, fmt.Errorf("bw6761/groth16: vk has %d G1Kvk entries but %d variable names", len(vk.G1Kvk), len(names))
+	}
+
+	var acc bw6761.G1Jac
+	for i, name := range names {
+		v, ok := solution[name]
+		if !ok {
+			return bw6761.G1Affine{}, fmt.Errorf("bw6761/groth16: missing public input %q", name)
+		}
+		b, err := toBigInt(v)
+		if err != nil {
+			return bw6761.G1Affine{}, err
+		}
+		var term bw6761.G1Jac
+		term.FromAffine(&vk.G1Kvk[i])
+		term.ScalarMultiplication(&term, b)
+		acc.AddAssign(&term)
+	}
+
+	var res bw6761.G1Affine
+	res.FromJacobian(&acc)
+	return res, nil
+}
+
+// toBigInt converts a solution value to a *big.Int the way the other
+// curve backends' Prove/Verify do: it accepts anything fmt.Sprint turns
+// into a base-10 integer literal (int, int64, *big.Int, a numeric
+// string, ...).
+func toBigInt(v interface{}) (*big.Int, error) {
+	if b, ok := v.(*big.Int); ok {
+		return b, nil
+	}
+	b, ok := new(big.Int).SetString(fmt.Sprint(v), 10)
+	if !ok {
+		return nil, fmt.Errorf("bw6761/groth16: %v is not an integer", v)
+	}
+	return b, nil
+}
+
+// randScalar samples a uniformly random fr.Element from 128 bits of
+// entropy: enough that a cheating prover can't predict a combination of
+// false equations that cancels out under it.
+func randScalar() (fr.Element, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return fr.Element{}, err
+	}
+	var e fr.Element
+	e.SetBytes(buf[:])
+	return e, nil
+}
+
+// VerifyBLS377 is the in-circuit Groth16 verifier for proofs produced
+// over BLS12-377: it would assert (via cs.AssertIsEqual on the pairing
+// check's operands) that proof verifies against vk for publicWitness,
+// as constraints of the BW6-761 circuit being built by cs. This is
+// what makes recursion work: BLS12-377's base field is BW6-761's
+// scalar field, so the BLS12-377 group elements in proof/vk are native
+// BW6-761 circuit values instead of a foreign field that would need to
+// be emulated.
+//
+// Not implemented yet: always returns ErrNotImplemented.
+func VerifyBLS377(cs *frontend.ConstraintSystem, proof groth16_bls377.Proof, vk groth16_bls377.VerifyingKey, publicWitness map[string]frontend.Variable) error {
+	return ErrNotImplemented
+}
+
+// fpBytes is the serialized size of a BW6-761 base field element (761
+// bits); point coordinates live in this field, not in the 48-byte
+// scalar field. g1Bytes/g2Bytes are the uncompressed (X||Y) point sizes;
+// BW6-761's G2 is over the base field (not an extension, unlike
+// bn256/bls377/bls381), so G1 and G2 points are the same size. The
+// compressed sizes drop the Y coordinate, so they're half as large.
+const (
+	fpBytes = 96
+
+	g1Bytes           = 2 * fpBytes
+	g1BytesCompressed = fpBytes
+
+	g2Bytes           = 2 * fpBytes
+	g2BytesCompressed = fpBytes
+)
+
+func writeG1(w io.Writer, p *bw6761.G1Affine, compressed bool) (int64, error) {
+	if compressed {
+		b := p.CompressedBytes()
+		n, err := w.Write(b[:])
+		return int64(n), err
+	}
+	b := p.Bytes()
+	n, err := w.Write(b[:])
+	return int64(n), err
+}
+
+func readG1(r io.Reader, p *bw6761.G1Affine, compressed bool) (int64, error) {
+	size := g1Bytes
+	if compressed {
+		size = g1BytesCompressed
+	}
+	b := make([]byte, size)
+	n, err := io.ReadFull(r, b)
+	if err != nil {
+		return int64(n), err
+	}
+	if compressed {
+		if err := p.SetCompressedBytes(b); err != nil {
+			return int64(n), err
+		}
+		return int64(n), nil
+	}
+	p.SetBytes(b)
+	return int64(n), nil
+}
+
+func writeG2(w io.Writer, p *bw6761.G2Affine, compressed bool) (int64, error) {
+	if compressed {
+		b := p.CompressedBytes()
+		n, err := w.Write(b[:])
+		return int64(n), err
+	}
+	b := p.Bytes()
+	n, err := w.Write(b[:])
+	return int64(n), err
+}
+
+func readG2(r io.Reader, p *bw6761.G2Affine, compressed bool) (int64, error) {
+	size := g2Bytes
+	if compressed {
+		size = g2BytesCompressed
+	}
+	b := make([]byte, size)
+	n, err := io.ReadFull(r, b)
+	if err != nil {
+		return int64(n), err
+	}
+	if compressed {
+		if err := p.SetCompressedBytes(b); err != nil {
+			return int64(n), err
+		}
+		return int64(n), nil
+	}
+	p.SetBytes(b)
+	return int64(n), nil
+}
+
+func writeG1Slice(w io.Writer, pts []bw6761.G1Affine, compressed bool) (int64, error) {
+	var written int64
+	if err := binary.Write(w, binary.BigEndian, uint32(len(pts))); err != nil {
+		return written, err
+	}
+	written += 4
+	for i := range pts {
+		n, err := writeG1(w, &pts[i], compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+func readG1Slice(r io.Reader, compressed bool) ([]bw6761.G1Affine, int64, error) {
+	var read int64
+	var nb uint32
+	if err := binary.Read(r, binary.BigEndian, &nb); err != nil {
+		return nil, read, err
+	}
+	read += 4
+	pts := make([]bw6761.G1Affine, nb)
+	for i := range pts {
+		n, err := readG1(r, &pts[i], compressed)
+		read += n
+		if err != nil {
+			return nil, read, err
+		}
+	}
+	return pts, read, nil
+}
+
+func writeG2Slice(w io.Writer, pts []bw6761.G2Affine, compressed bool) (int64, error) {
+	var written int64
+	if err := binary.Write(w, binary.BigEndian, uint32(len(pts))); err != nil {
+		return written, err
+	}
+	written += 4
+	for i := range pts {
+		n, err := writeG2(w, &pts[i], compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+func readG2Slice(r io.Reader, compressed bool) ([]bw6761.G2Affine, int64, error) {
+	var read int64
+	var nb uint32
+	if err := binary.Read(r, binary.BigEndian, &nb); err != nil {
+		return nil, read, err
+	}
+	read += 4
+	pts := make([]bw6761.G2Affine, nb)
+	for i := range pts {
+		n, err := readG2(r, &pts[i], compressed)
+		read += n
+		if err != nil {
+			return nil, read, err
+		}
+	}
+	return pts, read, nil
+}
+
+// WriteTo implements encoding.WriterTo.
+func (pk *ProvingKey) WriteTo(w io.Writer, compressed bool) (int64, error) {
+	var written int64
+
+	n, err := pk.Domain.WriteTo(w)
+	written += n
+	if err != nil {
+		return written, err
+	}
+
+	for _, p := range []*bw6761.G1Affine{&pk.G1.Alpha, &pk.G1.Beta, &pk.G1.Delta} {
+		n, err = writeG1(w, p, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	for _, s := range [][]bw6761.G1Affine{pk.G1.A, pk.G1.B, pk.G1.Z, pk.G1.K} {
+		n, err = writeG1Slice(w, s, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+
+	for _, p := range []*bw6761.G2Affine{&pk.G2.Beta, &pk.G2.Delta} {
+		n, err = writeG2(w, p, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	n, err = writeG2Slice(w, pk.G2.B, compressed)
+	written += n
+	return written, err
+}
+
+// ReadFrom implements encoding.ReaderFrom.
+func (pk *ProvingKey) ReadFrom(r io.Reader, compressed bool) (int64, error) {
+	var read int64
+
+	n, err := pk.Domain.ReadFrom(r)
+	read += n
+	if err != nil {
+		return read, err
+	}
+
+	for _, p := range []*bw6761.G1Affine{&pk.G1.Alpha, &pk.G1.Beta, &pk.G1.Delta} {
+		n, err = readG1(r, p, compressed)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	pk.G1.A, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	pk.G1.B, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	pk.G1.Z, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	pk.G1.K, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+
+	for _, p := range []*bw6761.G2Affine{&pk.G2.Beta, &pk.G2.Delta} {
+		n, err = readG2(r, p, compressed)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	pk.G2.B, n, err = readG2Slice(r, compressed)
+	read += n
+	return read, err
+}
+
+// WriteTo implements encoding.WriterTo.
+func (vk *VerifyingKey) WriteTo(w io.Writer, compressed bool) (int64, error) {
+	var written int64
+
+	n, err := writeG1(w, &vk.G1.Alpha, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	for _, p := range []*bw6761.G2Affine{&vk.G2.Beta, &vk.G2.Gamma, &vk.G2.Delta} {
+		n, err = writeG2(w, p, compressed)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	n, err = writeG1Slice(w, vk.G1Kvk, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+
+	if err := binary.Write(w, binary.BigEndian, uint32(len(vk.PublicVariableNames))); err != nil {
+		return written, err
+	}
+	written += 4
+	for _, name := range vk.PublicVariableNames {
+		if err := binary.Write(w, binary.BigEndian, uint32(len(name))); err != nil {
+			return written, err
+		}
+		written += 4
+		n, err := io.WriteString(w, name)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// ReadFrom implements encoding.ReaderFrom.
+func (vk *VerifyingKey) ReadFrom(r io.Reader, compressed bool) (int64, error) {
+	var read int64
+
+	n, err := readG1(r, &vk.G1.Alpha, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	for _, p := range []*bw6761.G2Affine{&vk.G2.Beta, &vk.G2.Gamma, &vk.G2.Delta} {
+		n, err = readG2(r, p, compressed)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	vk.G1Kvk, n, err = readG1Slice(r, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+
+	var nbNames uint32
+	if err := binary.Read(r, binary.BigEndian, &nbNames); err != nil {
+		return read, err
+	}
+	read += 4
+	vk.PublicVariableNames = make([]string, nbNames)
+	for i := range vk.PublicVariableNames {
+		var l uint32
+		if err := binary.Read(r, binary.BigEndian, &l); err != nil {
+			return read, err
+		}
+		read += 4
+		buf := make([]byte, l)
+		n, err := io.ReadFull(r, buf)
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		vk.PublicVariableNames[i] = string(buf)
+	}
+
+	return read, nil
+}
+
+// WriteTo implements encoding.WriterTo.
+func (proof *Proof) WriteTo(w io.Writer, compressed bool) (int64, error) {
+	var written int64
+
+	n, err := writeG1(w, &proof.Ar, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	n, err = writeG2(w, &proof.Bs, compressed)
+	written += n
+	if err != nil {
+		return written, err
+	}
+	n, err = writeG1(w, &proof.Krs, compressed)
+	written += n
+	return written, err
+}
+
+// ReadFrom implements encoding.ReaderFrom.
+func (proof *Proof) ReadFrom(r io.Reader, compressed bool) (int64, error) {
+	var read int64
+
+	n, err := readG1(r, &proof.Ar, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	n, err = readG2(r, &proof.Bs, compressed)
+	read += n
+	if err != nil {
+		return read, err
+	}
+	n, err = readG1(r, &proof.Krs, compressed)
+	read += n
+	return read, err
+}
@@ -0,0 +1,180 @@
+// Package bw6761 implements the R1CS (rank 1 constraint system) used by
+// the groth16 backend in backend/bw6761/groth16. BW6-761 is chosen as
+// an outer curve: its scalar field matches BLS12-377's base field, so a
+// circuit over BW6-761 can verify a Groth16 proof produced over
+// BLS12-377 natively, without simulating foreign-field arithmetic.
+// This is what makes recursive proof composition (verify a proof
+// inside a circuit, then prove that) practical.
+package bw6761
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/consensys/gurvy/bw6761/fr"
+)
+
+// frBytes is the size in bytes of a serialized fr.Element: BW6-761's
+// scalar field matches BLS12-377's base field, a ~377-bit prime.
+const frBytes = 48
+
+// Term is one (coefficient, wire) pair in a linear combination.
+type Term struct {
+	Coeff  fr.Element
+	WireID int
+}
+
+// LinearCombination is a sum of Terms, the left/right/output side of an
+// R1CS constraint.
+type LinearCombination []Term
+
+// R1C is a single rank-1 constraint: L * R = O.
+type R1C struct {
+	L, R, O LinearCombination
+}
+
+// R1CS is the BW6-761 rank 1 constraint system produced by compiling a
+// frontend.CircuitSystem over this curve.
+type R1CS struct {
+	NbPublicWires  int
+	NbPrivateWires int
+	WireNames      []string
+	Constraints    []R1C
+}
+
+// NewR1CS returns an empty R1CS with room for the given number of
+// constraints, mirroring the other curve backends' constructors.
+func NewR1CS(nbConstraints int) *R1CS {
+	return &R1CS{Constraints: make([]R1C, 0, nbConstraints)}
+}
+
+// Variables implements witness.Schema: it returns the declared wire
+// names in assignment order (public wires first, matching the order
+// groth16.Setup expects solutions in).
+func (cs *R1CS) Variables() []string {
+	return cs.WireNames
+}
+
+// WriteTo serializes cs, implementing io.WriterTo. It writes no
+// encoding package header.
+func (cs *R1CS) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+
+	header := []int{cs.NbPublicWires, cs.NbPrivateWires, len(cs.WireNames), len(cs.Constraints)}
+	for _, v := range header {
+		if err := binary.Write(w, binary.BigEndian, uint32(v)); err != nil {
+			return written, err
+		}
+		written += 4
+	}
+
+	for _, name := range cs.WireNames {
+		if err := binary.Write(w, binary.BigEndian, uint32(len(name))); err != nil {
+			return written, err
+		}
+		written += 4
+		n, err := io.WriteString(w, name)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	for _, c := range cs.Constraints {
+		for _, lc := range [...]LinearCombination{c.L, c.R, c.O} {
+			if err := binary.Write(w, binary.BigEndian, uint32(len(lc))); err != nil {
+				return written, err
+			}
+			written += 4
+			for _, t := range lc {
+				if err := binary.Write(w, binary.BigEndian, uint32(t.WireID)); err != nil {
+					return written, err
+				}
+				written += 4
+				b := t.Coeff.Bytes()
+				n, err := w.Write(b[:])
+				written += int64(n)
+				if err != nil {
+					return written, err
+				}
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// ReadFrom deserializes cs, implementing io.ReaderFrom.
+func (cs *R1CS) ReadFrom(r io.Reader) (int64, error) {
+	var read int64
+
+	readUint32 := func() (uint32, error) {
+		var v uint32
+		err := binary.Read(r, binary.BigEndian, &v)
+		read += 4
+		return v, err
+	}
+
+	nbPublic, err := readUint32()
+	if err != nil {
+		return read, err
+	}
+	nbPrivate, err := readUint32()
+	if err != nil {
+		return read, err
+	}
+	nbNames, err := readUint32()
+	if err != nil {
+		return read, err
+	}
+	nbConstraints, err := readUint32()
+	if err != nil {
+		return read, err
+	}
+	cs.NbPublicWires = int(nbPublic)
+	cs.NbPrivateWires = int(nbPrivate)
+
+	cs.WireNames = make([]string, nbNames)
+	for i := range cs.WireNames {
+		l, err := readUint32()
+		if err != nil {
+			return read, err
+		}
+		buf := make([]byte, l)
+		n, err := io.ReadFull(r, buf)
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		cs.WireNames[i] = string(buf)
+	}
+
+	cs.Constraints = make([]R1C, nbConstraints)
+	for i := range cs.Constraints {
+		lcs := [3]*LinearCombination{&cs.Constraints[i].L, &cs.Constraints[i].R, &cs.Constraints[i].O}
+		for _, lc := range lcs {
+			nbTerms, err := readUint32()
+			if err != nil {
+				return read, err
+			}
+			*lc = make(LinearCombination, nbTerms)
+			for j := range *lc {
+				wireID, err := readUint32()
+				if err != nil {
+					return read, err
+				}
+				buf := make([]byte, frBytes)
+				n, err := io.ReadFull(r, buf)
+				read += int64(n)
+				if err != nil {
+					return read, err
+				}
+				var coeff fr.Element
+				coeff.SetBytes(buf)
+				(*lc)[j] = Term{WireID: int(wireID), Coeff: coeff}
+			}
+		}
+	}
+
+	return read, nil
+}
@@ -0,0 +1,190 @@
+package groth16
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheBudget is the memory budget, in bytes, DefaultCache is
+// created with: large enough to hold a handful of typical proving
+// keys without configuration, small enough not to surprise a caller
+// who never asked for caching.
+const DefaultCacheBudget = 4 << 30 // 4 GiB
+
+// DefaultCache is the KeyCache used implicitly by ReadProvingKey and
+// ReadVerifyingKey unless a call site passes WithCache.
+var DefaultCache = NewKeyCache(DefaultCacheBudget, 0)
+
+type cacheEntry struct {
+	id        string
+	value     interface{} // ProvingKey or VerifyingKey
+	size      int64
+	expiresAt time.Time // zero means no expiry
+}
+
+// KeyCache memoizes ProvingKey/VerifyingKey values loaded from disk by
+// a content-addressed ID (the sha256 of the file, or a caller-supplied
+// one), so a server handling many Prove/Verify requests for the same
+// circuit only pays the deserialization cost once. Eviction is LRU
+// against a byte budget; entries also expire after ttl if one is set.
+// Safe for concurrent use: concurrent first-touches of the same ID
+// deserialize only once, the rest wait on and share that result.
+type KeyCache struct {
+	maxBytes int64
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	ll        *list.List // of *cacheEntry, front = most recently used
+	items     map[string]*list.Element
+	sizeBytes int64
+
+	group singleflight.Group
+}
+
+// NewKeyCache creates a KeyCache evicting least-recently-used entries
+// once the total size of cached values would exceed maxBytes. ttl of 0
+// means entries never expire on their own (only via LRU eviction).
+func NewKeyCache(maxBytes int64, ttl time.Duration) *KeyCache {
+	return &KeyCache{
+		maxBytes: maxBytes,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached value for id, if present and not expired.
+func (c *KeyCache) Get(id string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.removeElement(el)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+// Put inserts value under id, evicting least-recently-used entries
+// until the cache fits within maxBytes. The entry just inserted is never
+// evicted by its own Put, so a value larger than maxBytes stays cached
+// on its own.
+func (c *KeyCache) Put(id string, value interface{}, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[id]; ok {
+		c.removeElement(el)
+	}
+
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = time.Now().Add(c.ttl)
+	}
+	el := c.ll.PushFront(&cacheEntry{id: id, value: value, size: size, expiresAt: expiresAt})
+	c.items[id] = el
+	c.sizeBytes += size
+
+	for c.sizeBytes > c.maxBytes && c.ll.Len() > 1 {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+func (c *KeyCache) removeElement(el *list.Element) {
+	entry := el.Value.(*cacheEntry)
+	c.ll.Remove(el)
+	delete(c.items, entry.id)
+	c.sizeBytes -= entry.size
+}
+
+// Load returns the cached value for id, calling load and caching its
+// result on a miss. Concurrent callers for the same id share a single
+// in-flight load (via singleflight), so load runs at most once per id
+// even under concurrent first touches.
+func (c *KeyCache) Load(id string, size int64, load func() (interface{}, error)) (interface{}, error) {
+	if v, ok := c.Get(id); ok {
+		return v, nil
+	}
+
+	v, err, _ := c.group.Do(id, func() (interface{}, error) {
+		if v, ok := c.Get(id); ok {
+			return v, nil
+		}
+		v, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.Put(id, v, size)
+		return v, nil
+	})
+	return v, err
+}
+
+// options configures the Read* helpers; see WithCache and WithKeyID.
+type options struct {
+	cache *KeyCache
+	keyID string
+}
+
+// Option configures ReadProvingKey/ReadVerifyingKey.
+type Option func(*options)
+
+// WithCache makes ReadProvingKey/ReadVerifyingKey use cache instead of
+// DefaultCache. Passing a nil cache disables caching for that call,
+// which is useful for a one-off load that shouldn't evict hotter
+// entries from a shared cache.
+func WithCache(cache *KeyCache) Option {
+	return func(o *options) { o.cache = cache }
+}
+
+// WithKeyID makes ReadProvingKey/ReadVerifyingKey use id as the cache
+// key instead of hashing the file. A caller that already knows a
+// stable identity for a key (a circuit name and version, say) can use
+// this to skip reading and hashing the whole file on every call, which
+// is the cost caching is meant to avoid in the first place; without it,
+// every call pays a full read of path to compute the default
+// content-addressed key, cache hit or not.
+func WithKeyID(id string) Option {
+	return func(o *options) { o.keyID = id }
+}
+
+func newOptions(opts []Option) options {
+	o := options{cache: DefaultCache}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// hashFile returns the sha256 of path's contents, hex-encoded, along
+// with its size, for use as a cache key when the caller hasn't supplied
+// one via WithKeyID. It reads and hashes the whole file every time it
+// is called; ReadProvingKey/ReadVerifyingKey call it on every call made
+// without WithKeyID (and with a non-nil cache), cache hit or not.
+func hashFile(path string) (id string, size int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
@@ -1,16 +1,24 @@
 package groth16
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
 	backend_bls377 "github.com/consensys/gnark/backend/bls377"
 	backend_bls381 "github.com/consensys/gnark/backend/bls381"
 	backend_bn256 "github.com/consensys/gnark/backend/bn256"
+	backend_bw6761 "github.com/consensys/gnark/backend/bw6761"
 	"github.com/consensys/gnark/encoding"
 	"github.com/consensys/gurvy"
 
 	groth16_bls377 "github.com/consensys/gnark/backend/bls377/groth16"
 	groth16_bls381 "github.com/consensys/gnark/backend/bls381/groth16"
 	groth16_bn256 "github.com/consensys/gnark/backend/bn256/groth16"
+	groth16_bw6761 "github.com/consensys/gnark/backend/bw6761/groth16"
 	"github.com/consensys/gnark/backend/r1cs"
+	"github.com/consensys/gnark/witness"
 )
 
 // Proof represents a Groth16 proof generated by groth16.Prove
@@ -39,6 +47,103 @@ func Verify(proof Proof, vk VerifyingKey, solution map[string]interface{}) error
 		return groth16_bls381.Verify(_proof, vk.(*groth16_bls381.VerifyingKey), solution)
 	case *groth16_bn256.Proof:
 		return groth16_bn256.Verify(_proof, vk.(*groth16_bn256.VerifyingKey), solution)
+	case *groth16_bw6761.Proof:
+		return groth16_bw6761.Verify(_proof, vk.(*groth16_bw6761.VerifyingKey), solution)
+	default:
+		panic("unrecognized R1CS curve type")
+	}
+}
+
+// VerifyBatch runs groth16.Verify on N proofs faster than N independent
+// calls to Verify, by collapsing the O(N) independent e(A_i,B_i)
+// pairings Groth16 verification would otherwise require into a single
+// batched check.
+//
+// Groth16 verification checks e(A,B) = e(alpha,beta)*e(vk_x,gamma)*e(C,delta)
+// for one proof. Given N proofs sharing the same VerifyingKey, sample
+// independent random scalars r_i (128 bits of entropy is enough: a
+// cheating prover would need to find a combination of false equations
+// that cancels out under a random linear combination it can't predict)
+// and check instead:
+//
+//	prod_i e(r_i*A_i, B_i) = e(sum_i r_i*alpha, beta) * e(sum_i r_i*vk_x_i, gamma) * e(sum_i r_i*C_i, delta)
+//
+// The left side still needs one Miller loop per proof (each B_i
+// differs), but the right side collapses the three pairings every
+// single-proof Verify would redo into one pairing each, regardless of
+// N, and the whole batch shares one final exponentiation.
+//
+// All proofs must be for the same VerifyingKey; proofs and vks must
+// have the same length and be proofs/keys for the same curve.
+func VerifyBatch(proofs []Proof, vks []VerifyingKey, solutions []map[string]interface{}) error {
+	if len(proofs) != len(vks) || len(proofs) != len(solutions) {
+		return fmt.Errorf("groth16: VerifyBatch needs the same number of proofs, verifying keys and solutions")
+	}
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	switch proofs[0].(type) {
+	case *groth16_bls377.Proof:
+		_proofs := make([]*groth16_bls377.Proof, len(proofs))
+		_vks := make([]*groth16_bls377.VerifyingKey, len(vks))
+		for i := range proofs {
+			p, ok := proofs[i].(*groth16_bls377.Proof)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch proof %d is %T, want %T", i, proofs[i], p)
+			}
+			vk, ok := vks[i].(*groth16_bls377.VerifyingKey)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch vk %d is %T, want %T", i, vks[i], vk)
+			}
+			_proofs[i], _vks[i] = p, vk
+		}
+		return groth16_bls377.VerifyBatch(_proofs, _vks, solutions)
+	case *groth16_bls381.Proof:
+		_proofs := make([]*groth16_bls381.Proof, len(proofs))
+		_vks := make([]*groth16_bls381.VerifyingKey, len(vks))
+		for i := range proofs {
+			p, ok := proofs[i].(*groth16_bls381.Proof)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch proof %d is %T, want %T", i, proofs[i], p)
+			}
+			vk, ok := vks[i].(*groth16_bls381.VerifyingKey)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch vk %d is %T, want %T", i, vks[i], vk)
+			}
+			_proofs[i], _vks[i] = p, vk
+		}
+		return groth16_bls381.VerifyBatch(_proofs, _vks, solutions)
+	case *groth16_bn256.Proof:
+		_proofs := make([]*groth16_bn256.Proof, len(proofs))
+		_vks := make([]*groth16_bn256.VerifyingKey, len(vks))
+		for i := range proofs {
+			p, ok := proofs[i].(*groth16_bn256.Proof)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch proof %d is %T, want %T", i, proofs[i], p)
+			}
+			vk, ok := vks[i].(*groth16_bn256.VerifyingKey)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch vk %d is %T, want %T", i, vks[i], vk)
+			}
+			_proofs[i], _vks[i] = p, vk
+		}
+		return groth16_bn256.VerifyBatch(_proofs, _vks, solutions)
+	case *groth16_bw6761.Proof:
+		_proofs := make([]*groth16_bw6761.Proof, len(proofs))
+		_vks := make([]*groth16_bw6761.VerifyingKey, len(vks))
+		for i := range proofs {
+			p, ok := proofs[i].(*groth16_bw6761.Proof)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch proof %d is %T, want %T", i, proofs[i], p)
+			}
+			vk, ok := vks[i].(*groth16_bw6761.VerifyingKey)
+			if !ok {
+				return fmt.Errorf("groth16: VerifyBatch vk %d is %T, want %T", i, vks[i], vk)
+			}
+			_proofs[i], _vks[i] = p, vk
+		}
+		return groth16_bw6761.VerifyBatch(_proofs, _vks, solutions)
 	default:
 		panic("unrecognized R1CS curve type")
 	}
@@ -55,31 +160,75 @@ func Prove(r1cs r1cs.R1CS, pk ProvingKey, solution map[string]interface{}) (Proo
 		return groth16_bls381.Prove(_r1cs, pk.(*groth16_bls381.ProvingKey), solution)
 	case *backend_bn256.R1CS:
 		return groth16_bn256.Prove(_r1cs, pk.(*groth16_bn256.ProvingKey), solution)
+	case *backend_bw6761.R1CS:
+		return groth16_bw6761.Prove(_r1cs, pk.(*groth16_bw6761.ProvingKey), solution)
 	default:
 		panic("unrecognized R1CS curve type")
 	}
 }
 
+// ProveWithWitness is Prove for callers holding a witness.Provider
+// instead of a map[string]interface{}: r1cs must also implement
+// witness.Schema so the provider can be drained in the right order.
+// It delegates to Prove once the provider has been resolved into a
+// solution, so it doesn't duplicate Prove's per-curve dispatch.
+func ProveWithWitness(cs r1cs.R1CS, pk ProvingKey, w witness.Provider) (Proof, error) {
+	schema, ok := cs.(witness.Schema)
+	if !ok {
+		return nil, fmt.Errorf("groth16: %T does not expose a witness schema", cs)
+	}
+	solution, err := witness.ToMap(w, schema)
+	if err != nil {
+		return nil, err
+	}
+	return Prove(cs, pk, solution)
+}
+
+// VerifyWithWitness is Verify for callers holding a witness.Provider
+// instead of a map[string]interface{}. vk must also implement
+// witness.Schema so the provider can be drained in the right order.
+func VerifyWithWitness(proof Proof, vk VerifyingKey, w witness.Provider) error {
+	schema, ok := vk.(witness.Schema)
+	if !ok {
+		return fmt.Errorf("groth16: %T does not expose a witness schema", vk)
+	}
+	solution, err := witness.ToMap(w, schema)
+	if err != nil {
+		return err
+	}
+	return Verify(proof, vk, solution)
+}
+
 // Setup runs groth16.Setup with provided R1CS
-// it checks the underlying type of the R1CS (curve specific) to call the proper implementation
-func Setup(r1cs r1cs.R1CS) (ProvingKey, VerifyingKey) {
+// it checks the underlying type of the R1CS (curve specific) to call the proper implementation.
+// Setup only fails when the curve-specific implementation itself does (e.g. bw6761's Setup, which
+// currently always returns groth16_bw6761.ErrNotImplemented); an unrecognized R1CS type is still a
+// programmer error and panics, same as every other dispatcher in this package.
+func Setup(r1cs r1cs.R1CS) (ProvingKey, VerifyingKey, error) {
 
 	switch _r1cs := r1cs.(type) {
 	case *backend_bls377.R1CS:
 		var pk groth16_bls377.ProvingKey
 		var vk groth16_bls377.VerifyingKey
 		groth16_bls377.Setup(_r1cs, &pk, &vk)
-		return &pk, &vk
+		return &pk, &vk, nil
 	case *backend_bls381.R1CS:
 		var pk groth16_bls381.ProvingKey
 		var vk groth16_bls381.VerifyingKey
 		groth16_bls381.Setup(_r1cs, &pk, &vk)
-		return &pk, &vk
+		return &pk, &vk, nil
 	case *backend_bn256.R1CS:
 		var pk groth16_bn256.ProvingKey
 		var vk groth16_bn256.VerifyingKey
 		groth16_bn256.Setup(_r1cs, &pk, &vk)
-		return &pk, &vk
+		return &pk, &vk, nil
+	case *backend_bw6761.R1CS:
+		var pk groth16_bw6761.ProvingKey
+		var vk groth16_bw6761.VerifyingKey
+		if err := groth16_bw6761.Setup(_r1cs, &pk, &vk); err != nil {
+			return nil, nil, err
+		}
+		return &pk, &vk, nil
 	default:
 		panic("unrecognized R1CS curve type")
 	}
@@ -87,96 +236,349 @@ func Setup(r1cs r1cs.R1CS) (ProvingKey, VerifyingKey) {
 
 // DummySetup create a random ProvingKey with provided R1CS
 // it doesn't return a VerifyingKey and is use for benchmarking or test purposes only.
-func DummySetup(r1cs r1cs.R1CS) ProvingKey {
+// Like Setup, it only returns an error when the curve-specific implementation does; an
+// unrecognized R1CS type still panics.
+func DummySetup(r1cs r1cs.R1CS) (ProvingKey, error) {
 	switch _r1cs := r1cs.(type) {
 	case *backend_bls377.R1CS:
 		var pk groth16_bls377.ProvingKey
 		groth16_bls377.DummySetup(_r1cs, &pk)
-		return &pk
+		return &pk, nil
 	case *backend_bls381.R1CS:
 		var pk groth16_bls381.ProvingKey
 		groth16_bls381.DummySetup(_r1cs, &pk)
-		return &pk
+		return &pk, nil
 	case *backend_bn256.R1CS:
 		var pk groth16_bn256.ProvingKey
 		groth16_bn256.DummySetup(_r1cs, &pk)
-		return &pk
+		return &pk, nil
+	case *backend_bw6761.R1CS:
+		var pk groth16_bw6761.ProvingKey
+		if err := groth16_bw6761.DummySetup(_r1cs, &pk); err != nil {
+			return nil, err
+		}
+		return &pk, nil
 	default:
 		panic("unrecognized R1CS curve type")
 	}
 }
 
-// ReadProvingKey ...
-// TODO likely temporary method, need a clean up pass on serialization things
-func ReadProvingKey(path string) (ProvingKey, error) {
-	curveID, err := encoding.PeekCurveID(path)
-	if err != nil {
-		return nil, err
-	}
-	var pk ProvingKey
+// ErrUnsupportedCurve is returned by the Read*/Write* helpers when the
+// curve ID found in (or passed to) them doesn't match any of the
+// backends compiled into this binary.
+var ErrUnsupportedCurve = errors.New("groth16: unsupported curve")
+
+// newProvingKey, newVerifyingKey and newProof allocate a zero-value,
+// curve-specific object for curveID. They are the single place that
+// needs to grow a case when a new curve backend is added.
+func newProvingKey(curveID gurvy.CurveID) (ProvingKey, error) {
 	switch curveID {
 	case gurvy.BN256:
-		pk = &groth16_bn256.ProvingKey{}
+		return &groth16_bn256.ProvingKey{}, nil
 	case gurvy.BLS377:
-		pk = &groth16_bls377.ProvingKey{}
+		return &groth16_bls377.ProvingKey{}, nil
 	case gurvy.BLS381:
-		pk = &groth16_bls381.ProvingKey{}
+		return &groth16_bls381.ProvingKey{}, nil
+	case gurvy.BW6761:
+		return &groth16_bw6761.ProvingKey{}, nil
 	default:
-		panic("not implemented")
+		return nil, ErrUnsupportedCurve
 	}
+}
 
-	if err := encoding.Read(path, pk, curveID); err != nil {
-		return nil, err
+func newVerifyingKey(curveID gurvy.CurveID) (VerifyingKey, error) {
+	switch curveID {
+	case gurvy.BN256:
+		return &groth16_bn256.VerifyingKey{}, nil
+	case gurvy.BLS377:
+		return &groth16_bls377.VerifyingKey{}, nil
+	case gurvy.BLS381:
+		return &groth16_bls381.VerifyingKey{}, nil
+	case gurvy.BW6761:
+		return &groth16_bw6761.VerifyingKey{}, nil
+	default:
+		return nil, ErrUnsupportedCurve
 	}
-	return pk, err
 }
 
-// ReadVerifyingKey ...
-// TODO likely temporary method, need a clean up pass on serialization things
-func ReadVerifyingKey(path string) (VerifyingKey, error) {
-	curveID, err := encoding.PeekCurveID(path)
-	if err != nil {
-		return nil, err
-	}
-	var vk VerifyingKey
+func newProof(curveID gurvy.CurveID) (Proof, error) {
 	switch curveID {
 	case gurvy.BN256:
-		vk = &groth16_bn256.VerifyingKey{}
+		return &groth16_bn256.Proof{}, nil
 	case gurvy.BLS377:
-		vk = &groth16_bls377.VerifyingKey{}
+		return &groth16_bls377.Proof{}, nil
 	case gurvy.BLS381:
-		vk = &groth16_bls381.VerifyingKey{}
+		return &groth16_bls381.Proof{}, nil
+	case gurvy.BW6761:
+		return &groth16_bw6761.Proof{}, nil
 	default:
-		panic("not implemented")
+		return nil, ErrUnsupportedCurve
 	}
+}
 
-	if err := encoding.Read(path, vk, curveID); err != nil {
+// curveIDOf returns the gurvy.CurveID matching the concrete,
+// curve-specific type behind key, so that Write* can fill in the header
+// without asking the caller to repeat the curve.
+func curveIDOf(key interface{}) (gurvy.CurveID, error) {
+	switch key.(type) {
+	case *groth16_bn256.ProvingKey, *groth16_bn256.VerifyingKey, *groth16_bn256.Proof:
+		return gurvy.BN256, nil
+	case *groth16_bls377.ProvingKey, *groth16_bls377.VerifyingKey, *groth16_bls377.Proof:
+		return gurvy.BLS377, nil
+	case *groth16_bls381.ProvingKey, *groth16_bls381.VerifyingKey, *groth16_bls381.Proof:
+		return gurvy.BLS381, nil
+	case *groth16_bw6761.ProvingKey, *groth16_bw6761.VerifyingKey, *groth16_bw6761.Proof:
+		return gurvy.BW6761, nil
+	default:
+		return 0, ErrUnsupportedCurve
+	}
+}
+
+// ReadProvingKeyFrom reads a ProvingKey written by WriteProvingKey (or
+// WriteProvingKeyTo) from r. It supports both compressed and
+// uncompressed point encodings transparently: that choice is recorded
+// in the header and doesn't need to be known by the caller.
+func ReadProvingKeyFrom(r io.Reader) (ProvingKey, error) {
+	h, err := encoding.ReadHeader(r)
+	if err != nil {
 		return nil, err
 	}
-	return vk, err
+	pk, err := newProvingKey(h.CurveID)
+	if err != nil {
+		return nil, err
+	}
+	rf, ok := pk.(encoding.ReaderFrom)
+	if !ok {
+		return nil, fmt.Errorf("groth16: %T does not implement encoding.ReaderFrom", pk)
+	}
+	if _, err := rf.ReadFrom(r, h.Compressed()); err != nil {
+		return nil, err
+	}
+	return pk, nil
 }
 
-// ReadProof ...
-// TODO likely temporary method, need a clean up pass on serialization things
-func ReadProof(path string) (Proof, error) {
-	curveID, err := encoding.PeekCurveID(path)
+// ReadVerifyingKeyFrom reads a VerifyingKey written by WriteVerifyingKey
+// (or WriteVerifyingKeyTo) from r.
+func ReadVerifyingKeyFrom(r io.Reader) (VerifyingKey, error) {
+	h, err := encoding.ReadHeader(r)
 	if err != nil {
 		return nil, err
 	}
-	var proof Proof
-	switch curveID {
-	case gurvy.BN256:
-		proof = &groth16_bn256.Proof{}
-	case gurvy.BLS377:
-		proof = &groth16_bls377.Proof{}
-	case gurvy.BLS381:
-		proof = &groth16_bls381.Proof{}
-	default:
-		panic("not implemented")
+	vk, err := newVerifyingKey(h.CurveID)
+	if err != nil {
+		return nil, err
+	}
+	rf, ok := vk.(encoding.ReaderFrom)
+	if !ok {
+		return nil, fmt.Errorf("groth16: %T does not implement encoding.ReaderFrom", vk)
+	}
+	if _, err := rf.ReadFrom(r, h.Compressed()); err != nil {
+		return nil, err
 	}
+	return vk, nil
+}
 
-	if err := encoding.Read(path, proof, curveID); err != nil {
+// ReadProofFrom reads a Proof written by WriteProof (or WriteProofTo)
+// from r.
+func ReadProofFrom(r io.Reader) (Proof, error) {
+	h, err := encoding.ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := newProof(h.CurveID)
+	if err != nil {
+		return nil, err
+	}
+	rf, ok := proof.(encoding.ReaderFrom)
+	if !ok {
+		return nil, fmt.Errorf("groth16: %T does not implement encoding.ReaderFrom", proof)
+	}
+	if _, err := rf.ReadFrom(r, h.Compressed()); err != nil {
 		return nil, err
 	}
-	return proof, err
+	return proof, nil
+}
+
+// WriteProvingKeyTo writes pk to w with a versioned header, using
+// compressed G1/G2 point encoding when compressed is true. Compressed
+// encoding roughly halves the size of the serialized key at the cost of
+// a point decompression (a square root in the base field) per point on
+// read.
+func WriteProvingKeyTo(w io.Writer, pk ProvingKey, compressed bool) (int64, error) {
+	curveID, err := curveIDOf(pk)
+	if err != nil {
+		return 0, err
+	}
+	wt, ok := pk.(encoding.WriterTo)
+	if !ok {
+		return 0, fmt.Errorf("groth16: %T does not implement encoding.WriterTo", pk)
+	}
+	return encoding.WriteTo(w, curveID, wt, compressed)
+}
+
+// WriteVerifyingKeyTo writes vk to w with a versioned header. Verifying
+// keys are small; compressed encoding is always used.
+func WriteVerifyingKeyTo(w io.Writer, vk VerifyingKey) (int64, error) {
+	curveID, err := curveIDOf(vk)
+	if err != nil {
+		return 0, err
+	}
+	wt, ok := vk.(encoding.WriterTo)
+	if !ok {
+		return 0, fmt.Errorf("groth16: %T does not implement encoding.WriterTo", vk)
+	}
+	return encoding.WriteTo(w, curveID, wt, true)
+}
+
+// WriteProofTo writes proof to w with a versioned header. Proofs are
+// always written with compressed points.
+func WriteProofTo(w io.Writer, proof Proof) (int64, error) {
+	curveID, err := curveIDOf(proof)
+	if err != nil {
+		return 0, err
+	}
+	wt, ok := proof.(encoding.WriterTo)
+	if !ok {
+		return 0, fmt.Errorf("groth16: %T does not implement encoding.WriterTo", proof)
+	}
+	return encoding.WriteTo(w, curveID, wt, true)
+}
+
+// ReadProvingKey opens path and reads a ProvingKey from it. Successive
+// calls for the same file content are served from cache (DefaultCache,
+// or the one passed via WithCache) instead of re-deserializing a
+// potentially multi-gigabyte key every time. See ReadProvingKeyFrom to
+// load a key from an io.Reader (S3, an embedded FS, a network
+// connection, ...) instead of a local path.
+func ReadProvingKey(path string, opts ...Option) (ProvingKey, error) {
+	cfg := newOptions(opts)
+	if cfg.cache == nil {
+		return readProvingKeyFile(path)
+	}
+
+	id, size, err := cacheKey("pk", path, cfg)
+	if err != nil {
+		return nil, err
+	}
+	v, err := cfg.cache.Load(id, size, func() (interface{}, error) {
+		return readProvingKeyFile(path)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(ProvingKey), nil
+}
+
+func readProvingKeyFile(path string) (ProvingKey, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadProvingKeyFrom(f)
+}
+
+// ReadVerifyingKey opens path and reads a VerifyingKey from it, using
+// the same cache as ReadProvingKey. See ReadVerifyingKeyFrom to load a
+// key from an io.Reader instead of a local path.
+func ReadVerifyingKey(path string, opts ...Option) (VerifyingKey, error) {
+	cfg := newOptions(opts)
+	if cfg.cache == nil {
+		return readVerifyingKeyFile(path)
+	}
+
+	id, size, err := cacheKey("vk", path, cfg)
+	if err != nil {
+		return nil, err
+	}
+	v, err := cfg.cache.Load(id, size, func() (interface{}, error) {
+		return readVerifyingKeyFile(path)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(VerifyingKey), nil
+}
+
+// cacheKey returns the cache key and size to use for path: cfg.keyID
+// plus a cheap os.Stat if the caller supplied one via WithKeyID, or the
+// sha256 of the whole file (hashFile) otherwise. The WithKeyID path is
+// what makes a cache hit actually skip reading the file's content.
+//
+// kind ("pk" or "vk") is prefixed onto the key so a ProvingKey and a
+// VerifyingKey loaded under the same caller-supplied WithKeyID land in
+// separate cache entries instead of one overwriting the other under a
+// type its value no longer matches: ReadProvingKey and ReadVerifyingKey
+// share DefaultCache, and cfg.keyID is under the caller's control, so
+// nothing else stops them colliding.
+func cacheKey(kind, path string, cfg options) (id string, size int64, err error) {
+	if cfg.keyID != "" {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return "", 0, err
+		}
+		return kind + ":" + cfg.keyID, fi.Size(), nil
+	}
+	id, size, err = hashFile(path)
+	if err != nil {
+		return "", 0, err
+	}
+	return kind + ":" + id, size, nil
+}
+
+func readVerifyingKeyFile(path string) (VerifyingKey, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadVerifyingKeyFrom(f)
+}
+
+// ReadProof opens path and reads a Proof from it. See ReadProofFrom to
+// load a proof from an io.Reader instead of a local path.
+func ReadProof(path string) (Proof, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadProofFrom(f)
+}
+
+// WriteProvingKey creates (or truncates) path and writes pk to it.
+// compressed trades load time (a point decompression per point on read)
+// for roughly half the disk footprint;
+// pick it for keys that are distributed/archived rather than read back
+// immediately on the same machine.
+func WriteProvingKey(path string, pk ProvingKey, compressed bool) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = WriteProvingKeyTo(f, pk, compressed)
+	return err
+}
+
+// WriteVerifyingKey creates (or truncates) path and writes vk to it.
+func WriteVerifyingKey(path string, vk VerifyingKey) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = WriteVerifyingKeyTo(f, vk)
+	return err
+}
+
+// WriteProof creates (or truncates) path and writes proof to it.
+func WriteProof(path string, proof Proof) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = WriteProofTo(f, proof)
+	return err
 }
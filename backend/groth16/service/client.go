@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/groth16/service/pb"
+	"github.com/consensys/gurvy"
+	"google.golang.org/grpc"
+)
+
+var curveIDToPB = map[gurvy.CurveID]pb.CurveID{
+	gurvy.BN256:  pb.CurveID_BN256,
+	gurvy.BLS377: pb.CurveID_BLS377,
+	gurvy.BLS381: pb.CurveID_BLS381,
+}
+
+// Client talks to a Server over gRPC. Its Prove/Verify methods take the
+// same solution map as the local groth16.Prove/groth16.Verify, with a
+// context and a KeyID (from LoadKey) in place of the R1CS and keys.
+type Client struct {
+	rpc pb.ProverClient
+}
+
+// NewClient wraps an established gRPC connection to a Prover service.
+// cc must have been dialed with pb.DialOption() (in addition to
+// whatever transport credentials/options the caller needs), or every
+// RPC will fail: this service's messages ride gRPC's pluggable codec,
+// not the default protobuf one.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{rpc: pb.NewProverClient(cc)}
+}
+
+// KeyID identifies a key pair previously loaded on the server via
+// LoadKey or Setup.
+type KeyID struct {
+	curveID gurvy.CurveID
+	value   string
+}
+
+// LoadKey uploads cs/pk/vk to the server once and returns a KeyID that
+// Prove/Verify can reuse across many calls without re-sending them.
+func (c *Client) LoadKey(ctx context.Context, curveID gurvy.CurveID, cs io.WriterTo, pk groth16.ProvingKey, vk groth16.VerifyingKey) (*KeyID, error) {
+	pbCurve, ok := curveIDToPB[curveID]
+	if !ok {
+		return nil, fmt.Errorf("service: unsupported curve %s", curveID.String())
+	}
+
+	var csBuf, pkBuf, vkBuf bytes.Buffer
+	if _, err := cs.WriteTo(&csBuf); err != nil {
+		return nil, fmt.Errorf("service: encoding r1cs: %w", err)
+	}
+	if _, err := groth16.WriteProvingKeyTo(&pkBuf, pk, false); err != nil {
+		return nil, fmt.Errorf("service: encoding proving key: %w", err)
+	}
+	if _, err := groth16.WriteVerifyingKeyTo(&vkBuf, vk); err != nil {
+		return nil, fmt.Errorf("service: encoding verifying key: %w", err)
+	}
+
+	resp, err := c.rpc.LoadKey(ctx, &pb.LoadKeyRequest{
+		Curve:        pbCurve,
+		R1cs:         &pb.Bytes{Data: csBuf.Bytes()},
+		ProvingKey:   &pb.Bytes{Data: pkBuf.Bytes()},
+		VerifyingKey: &pb.Bytes{Data: vkBuf.Bytes()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &KeyID{curveID: curveID, value: resp.KeyId.Value}, nil
+}
+
+// Prove asks the server to run groth16.Prove for keyID against
+// solution, returning the resulting Proof decoded locally.
+func (c *Client) Prove(ctx context.Context, keyID *KeyID, solution map[string]interface{}) (groth16.Proof, error) {
+	resp, err := c.rpc.Prove(ctx, &pb.ProveRequest{
+		KeyId:   &pb.KeyID{Value: keyID.value},
+		Witness: solutionToWitness(solution),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groth16.ReadProofFrom(bytes.NewReader(resp.Proof.Data))
+}
+
+// Verify asks the server to run groth16.Verify for keyID against proof
+// and solution.
+func (c *Client) Verify(ctx context.Context, keyID *KeyID, proof groth16.Proof, solution map[string]interface{}) error {
+	var proofBuf bytes.Buffer
+	if _, err := groth16.WriteProofTo(&proofBuf, proof); err != nil {
+		return fmt.Errorf("service: encoding proof: %w", err)
+	}
+
+	resp, err := c.rpc.Verify(ctx, &pb.VerifyRequest{
+		KeyId:         &pb.KeyID{Value: keyID.value},
+		Proof:         &pb.Bytes{Data: proofBuf.Bytes()},
+		PublicWitness: solutionToWitness(solution),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Valid {
+		return fmt.Errorf("service: invalid proof: %s", resp.Error)
+	}
+	return nil
+}
+
+// ProveBatch runs groth16.Prove for keyID against each solution in
+// solutions over a single streaming RPC instead of len(solutions) Prove
+// calls, decoding each resulting proof locally. Server.ProveBatch
+// processes solutions in submission order, so the returned proofs line
+// up with solutions by index.
+func (c *Client) ProveBatch(ctx context.Context, keyID *KeyID, solutions []map[string]interface{}) ([]groth16.Proof, error) {
+	stream, err := c.rpc.ProveBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, solution := range solutions {
+		req := &pb.ProveRequest{
+			KeyId:   &pb.KeyID{Value: keyID.value},
+			Witness: solutionToWitness(solution),
+		}
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	proofs := make([]groth16.Proof, len(solutions))
+	for i := range solutions {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		proof, err := groth16.ReadProofFrom(bytes.NewReader(resp.Proof.Data))
+		if err != nil {
+			return nil, err
+		}
+		proofs[i] = proof
+	}
+	return proofs, nil
+}
+
+// VerifyingKey adapts a (Client, KeyID) pair to the groth16.VerifyingKey
+// interface. The top-level groth16 functions do not accept it in place
+// of a local key: groth16.Verify and VerifyBatch handle only the
+// curve-specific VerifyingKey types, and it does not implement
+// witness.Schema for VerifyWithWitness.
+type VerifyingKey struct {
+	Client *Client
+	KeyID  *KeyID
+}
+
+// IsDifferent satisfies groth16.VerifyingKey. Two remote keys are
+// considered different unless they share both a client and a KeyID.
+func (vk *VerifyingKey) IsDifferent(other interface{}) bool {
+	o, ok := other.(*VerifyingKey)
+	if !ok {
+		return true
+	}
+	return vk.Client != o.Client || vk.KeyID.value != o.KeyID.value
+}
+
+// solutionToWitness converts the map[string]interface{} solution
+// accepted by groth16.Prove/Verify into the wire Witness, formatting
+// every value with fmt.Sprint so the server doesn't need to know the
+// concrete Go type a caller used for a given assignment.
+func solutionToWitness(solution map[string]interface{}) *pb.Witness {
+	assignments := make(map[string]string, len(solution))
+	for k, v := range solution {
+		assignments[k] = fmt.Sprint(v)
+	}
+	return &pb.Witness{Assignments: assignments}
+}
@@ -0,0 +1,49 @@
+package pb
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// codecName is the gRPC content-subtype this package's messages are
+// carried under. It is not "proto": the types in this package are
+// plain Go structs with protobuf field tags kept for documentation
+// purposes only, not registered *_proto.Message implementations, so
+// they cannot go through gRPC's default proto codec.
+const codecName = "gnarkgob"
+
+func init() {
+	encoding.RegisterCodec(gobCodec{})
+}
+
+// gobCodec implements encoding.Codec using encoding/gob, which is
+// enough for plain exported-field structs like the ones in this
+// package: no descriptor, reflection-info or generated marshal code is
+// needed the way real protobuf messages require.
+type gobCodec struct{}
+
+func (gobCodec) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+func (gobCodec) Name() string {
+	return codecName
+}
+
+// DialOption returns the grpc.DialOption a Client's underlying
+// connection must be created with, so requests are encoded with this
+// package's codec instead of gRPC's default (protobuf) one.
+func DialOption() grpc.DialOption {
+	return grpc.WithDefaultCallOptions(grpc.CallContentSubtype(codecName))
+}
@@ -0,0 +1,227 @@
+// Package pb defines the wire messages for the Prover gRPC service
+// described by service.proto. They are plain Go structs rather than
+// protoc-gen-go output: this package registers its own gRPC codec (see
+// codec.go) that encodes them with encoding/gob, so none of the
+// proto.Message machinery (Reset/String/ProtoReflect, a compiled
+// FileDescriptorProto, ...) real generated code would need is required.
+// The protobuf struct tags are kept only as documentation of the wire
+// shape service.proto describes.
+package pb
+
+type CurveID int32
+
+const (
+	CurveID_BN256  CurveID = 0
+	CurveID_BLS377 CurveID = 1
+	CurveID_BLS381 CurveID = 2
+)
+
+var CurveID_name = map[int32]string{
+	0: "BN256",
+	1: "BLS377",
+	2: "BLS381",
+}
+
+type KeyID struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3"`
+}
+
+type Bytes struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3"`
+}
+
+type Witness struct {
+	Assignments map[string]string `protobuf:"bytes,1,rep,name=assignments,proto3"`
+}
+
+type LoadKeyRequest struct {
+	Curve        CurveID `protobuf:"varint,1,opt,name=curve,proto3,enum=gnark.groth16.service.CurveID"`
+	R1cs         *Bytes  `protobuf:"bytes,2,opt,name=r1cs,proto3"`
+	ProvingKey   *Bytes  `protobuf:"bytes,3,opt,name=proving_key,json=provingKey,proto3"`
+	VerifyingKey *Bytes  `protobuf:"bytes,4,opt,name=verifying_key,json=verifyingKey,proto3"`
+}
+
+type LoadKeyResponse struct {
+	KeyId *KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3"`
+}
+
+type ProveRequest struct {
+	KeyId   *KeyID   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3"`
+	Witness *Witness `protobuf:"bytes,2,opt,name=witness,proto3"`
+}
+
+type ProveResponse struct {
+	Proof *Bytes `protobuf:"bytes,1,opt,name=proof,proto3"`
+}
+
+type VerifyRequest struct {
+	KeyId         *KeyID   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3"`
+	Proof         *Bytes   `protobuf:"bytes,2,opt,name=proof,proto3"`
+	PublicWitness *Witness `protobuf:"bytes,3,opt,name=public_witness,json=publicWitness,proto3"`
+}
+
+type VerifyResponse struct {
+	Valid bool   `protobuf:"varint,1,opt,name=valid,proto3"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3"`
+}
+
+type SetupRequest struct {
+	Curve CurveID `protobuf:"varint,1,opt,name=curve,proto3,enum=gnark.groth16.service.CurveID"`
+	R1cs  *Bytes  `protobuf:"bytes,2,opt,name=r1cs,proto3"`
+	Dummy bool    `protobuf:"varint,3,opt,name=dummy,proto3"`
+}
+
+type SetupResponse struct {
+	KeyId        *KeyID `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3"`
+	VerifyingKey *Bytes `protobuf:"bytes,2,opt,name=verifying_key,json=verifyingKey,proto3"`
+}
+
+// GetValue is a nil-safe accessor: it returns the zero value for a nil
+// *KeyID instead of panicking, the same convention protoc-gen-go
+// generates for every field.
+func (m *KeyID) GetValue() string {
+	if m == nil {
+		return ""
+	}
+	return m.Value
+}
+
+func (m *Bytes) GetData() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Data
+}
+
+func (m *Witness) GetAssignments() map[string]string {
+	if m == nil {
+		return nil
+	}
+	return m.Assignments
+}
+
+func (m *LoadKeyRequest) GetCurve() CurveID {
+	if m == nil {
+		return CurveID_BN256
+	}
+	return m.Curve
+}
+
+func (m *LoadKeyRequest) GetR1cs() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.R1cs
+}
+
+func (m *LoadKeyRequest) GetProvingKey() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.ProvingKey
+}
+
+func (m *LoadKeyRequest) GetVerifyingKey() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.VerifyingKey
+}
+
+func (m *LoadKeyResponse) GetKeyId() *KeyID {
+	if m == nil {
+		return nil
+	}
+	return m.KeyId
+}
+
+func (m *ProveRequest) GetKeyId() *KeyID {
+	if m == nil {
+		return nil
+	}
+	return m.KeyId
+}
+
+func (m *ProveRequest) GetWitness() *Witness {
+	if m == nil {
+		return nil
+	}
+	return m.Witness
+}
+
+func (m *ProveResponse) GetProof() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.Proof
+}
+
+func (m *VerifyRequest) GetKeyId() *KeyID {
+	if m == nil {
+		return nil
+	}
+	return m.KeyId
+}
+
+func (m *VerifyRequest) GetProof() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.Proof
+}
+
+func (m *VerifyRequest) GetPublicWitness() *Witness {
+	if m == nil {
+		return nil
+	}
+	return m.PublicWitness
+}
+
+func (m *VerifyResponse) GetValid() bool {
+	if m == nil {
+		return false
+	}
+	return m.Valid
+}
+
+func (m *VerifyResponse) GetError() string {
+	if m == nil {
+		return ""
+	}
+	return m.Error
+}
+
+func (m *SetupRequest) GetCurve() CurveID {
+	if m == nil {
+		return CurveID_BN256
+	}
+	return m.Curve
+}
+
+func (m *SetupRequest) GetR1cs() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.R1cs
+}
+
+func (m *SetupRequest) GetDummy() bool {
+	if m == nil {
+		return false
+	}
+	return m.Dummy
+}
+
+func (m *SetupResponse) GetKeyId() *KeyID {
+	if m == nil {
+		return nil
+	}
+	return m.KeyId
+}
+
+func (m *SetupResponse) GetVerifyingKey() *Bytes {
+	if m == nil {
+		return nil
+	}
+	return m.VerifyingKey
+}
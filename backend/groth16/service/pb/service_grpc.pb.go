@@ -0,0 +1,237 @@
+// This file hand-implements the client/server stubs protoc-gen-go-grpc
+// would otherwise generate from service.proto's Prover service. See
+// codec.go for why: these stubs (and the plain-struct messages in
+// service.pb.go) ride gRPC's codec abstraction directly instead of
+// going through proto.Message, so there's no descriptor for a real
+// generator to work from yet.
+package pb
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+// ProverClient is the client API for Prover service.
+type ProverClient interface {
+	LoadKey(ctx context.Context, in *LoadKeyRequest, opts ...grpc.CallOption) (*LoadKeyResponse, error)
+	Setup(ctx context.Context, in *SetupRequest, opts ...grpc.CallOption) (*SetupResponse, error)
+	Prove(ctx context.Context, in *ProveRequest, opts ...grpc.CallOption) (*ProveResponse, error)
+	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
+	ProveBatch(ctx context.Context, opts ...grpc.CallOption) (Prover_ProveBatchClient, error)
+}
+
+type proverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewProverClient returns a ProverClient backed by cc.
+func NewProverClient(cc grpc.ClientConnInterface) ProverClient {
+	return &proverClient{cc}
+}
+
+func (c *proverClient) LoadKey(ctx context.Context, in *LoadKeyRequest, opts ...grpc.CallOption) (*LoadKeyResponse, error) {
+	out := new(LoadKeyResponse)
+	if err := c.cc.Invoke(ctx, "/gnark.groth16.service.Prover/LoadKey", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proverClient) Setup(ctx context.Context, in *SetupRequest, opts ...grpc.CallOption) (*SetupResponse, error) {
+	out := new(SetupResponse)
+	if err := c.cc.Invoke(ctx, "/gnark.groth16.service.Prover/Setup", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proverClient) Prove(ctx context.Context, in *ProveRequest, opts ...grpc.CallOption) (*ProveResponse, error) {
+	out := new(ProveResponse)
+	if err := c.cc.Invoke(ctx, "/gnark.groth16.service.Prover/Prove", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proverClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
+	out := new(VerifyResponse)
+	if err := c.cc.Invoke(ctx, "/gnark.groth16.service.Prover/Verify", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proverClient) ProveBatch(ctx context.Context, opts ...grpc.CallOption) (Prover_ProveBatchClient, error) {
+	stream, err := c.cc.(grpc.ClientConnInterface).NewStream(ctx, &Prover_ServiceDesc.Streams[0], "/gnark.groth16.service.Prover/ProveBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &proverProveBatchClient{stream}, nil
+}
+
+// Prover_ProveBatchClient is the bidirectional stream returned by
+// ProveBatch: Send a witness per call, Recv a proof per call, in
+// completion order rather than submission order.
+type Prover_ProveBatchClient interface {
+	Send(*ProveRequest) error
+	Recv() (*ProveResponse, error)
+	grpc.ClientStream
+}
+
+type proverProveBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *proverProveBatchClient) Send(m *ProveRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *proverProveBatchClient) Recv() (*ProveResponse, error) {
+	m := new(ProveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProverServer is the server API for Prover service.
+type ProverServer interface {
+	LoadKey(context.Context, *LoadKeyRequest) (*LoadKeyResponse, error)
+	Setup(context.Context, *SetupRequest) (*SetupResponse, error)
+	Prove(context.Context, *ProveRequest) (*ProveResponse, error)
+	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
+	ProveBatch(Prover_ProveBatchServer) error
+}
+
+// Prover_ProveBatchServer is the bidirectional stream seen server-side.
+type Prover_ProveBatchServer interface {
+	Send(*ProveResponse) error
+	Recv() (*ProveRequest, error)
+	grpc.ServerStream
+}
+
+type proverProveBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *proverProveBatchServer) Send(m *ProveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *proverProveBatchServer) Recv() (*ProveRequest, error) {
+	m := new(ProveRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnimplementedProverServer can be embedded in a Server implementation
+// to get forward-compatible behavior: methods added to ProverServer in
+// the future won't break existing implementations that embed it.
+type UnimplementedProverServer struct{}
+
+func (UnimplementedProverServer) LoadKey(context.Context, *LoadKeyRequest) (*LoadKeyResponse, error) {
+	return nil, errNotImplemented("LoadKey")
+}
+func (UnimplementedProverServer) Setup(context.Context, *SetupRequest) (*SetupResponse, error) {
+	return nil, errNotImplemented("Setup")
+}
+func (UnimplementedProverServer) Prove(context.Context, *ProveRequest) (*ProveResponse, error) {
+	return nil, errNotImplemented("Prove")
+}
+func (UnimplementedProverServer) Verify(context.Context, *VerifyRequest) (*VerifyResponse, error) {
+	return nil, errNotImplemented("Verify")
+}
+func (UnimplementedProverServer) ProveBatch(Prover_ProveBatchServer) error {
+	return errNotImplemented("ProveBatch")
+}
+
+func errNotImplemented(method string) error {
+	return fmt.Errorf("pb: method %s not implemented", method)
+}
+
+// RegisterProverServer registers srv with s under the Prover service
+// descriptor.
+func RegisterProverServer(s grpc.ServiceRegistrar, srv ProverServer) {
+	s.RegisterService(&Prover_ServiceDesc, srv)
+}
+
+// Prover_ServiceDesc is the grpc.ServiceDesc for the Prover service.
+var Prover_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gnark.groth16.service.Prover",
+	HandlerType: (*ProverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "LoadKey", Handler: _Prover_LoadKey_Handler},
+		{MethodName: "Setup", Handler: _Prover_Setup_Handler},
+		{MethodName: "Prove", Handler: _Prover_Prove_Handler},
+		{MethodName: "Verify", Handler: _Prover_Verify_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProveBatch",
+			Handler:       _Prover_ProveBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "backend/groth16/service/service.proto",
+}
+
+func _Prover_LoadKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProverServer).LoadKey(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/gnark.groth16.service.Prover/LoadKey"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProverServer).LoadKey(ctx, req.(*LoadKeyRequest))
+	})
+}
+
+func _Prover_Setup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProverServer).Setup(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/gnark.groth16.service.Prover/Setup"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProverServer).Setup(ctx, req.(*SetupRequest))
+	})
+}
+
+func _Prover_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProverServer).Prove(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/gnark.groth16.service.Prover/Prove"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProverServer).Prove(ctx, req.(*ProveRequest))
+	})
+}
+
+func _Prover_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProverServer).Verify(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/gnark.groth16.service.Prover/Verify"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProverServer).Verify(ctx, req.(*VerifyRequest))
+	})
+}
+
+func _Prover_ProveBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProverServer).ProveBatch(&proverProveBatchServer{stream})
+}
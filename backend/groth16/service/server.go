@@ -0,0 +1,250 @@
+// Package service exposes groth16 Setup/Prove/Verify over gRPC, so a
+// prover with a lot of memory or a GPU can sit behind the network and
+// be called from the same places that would otherwise call into the
+// groth16 package directly.
+//
+// Proving keys are loaded once (LoadKey) and kept server-side, indexed
+// by the sha256 of their serialized form; Prove/Verify requests then
+// carry only a witness and that key ID instead of the key itself.
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+
+	backend_bls377 "github.com/consensys/gnark/backend/bls377"
+	backend_bls381 "github.com/consensys/gnark/backend/bls381"
+	backend_bn256 "github.com/consensys/gnark/backend/bn256"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/backend/groth16/service/pb"
+	"github.com/consensys/gnark/backend/r1cs"
+	"github.com/consensys/gurvy"
+)
+
+var curveIDFromPB = map[pb.CurveID]gurvy.CurveID{
+	pb.CurveID_BN256:  gurvy.BN256,
+	pb.CurveID_BLS377: gurvy.BLS377,
+	pb.CurveID_BLS381: gurvy.BLS381,
+}
+
+type keyPair struct {
+	cs r1cs.R1CS
+	pk groth16.ProvingKey
+	vk groth16.VerifyingKey
+}
+
+// Server implements pb.ProverServer. It is safe for concurrent use.
+type Server struct {
+	pb.UnimplementedProverServer
+
+	mu   sync.RWMutex
+	keys map[string]keyPair
+}
+
+// NewServer returns an empty Server; keys are added through LoadKey.
+// Register it on a *grpc.Server as usual (pb.RegisterProverServer) — no
+// extra server-side option is needed for pb's codec: gRPC picks a
+// registered codec by the content-subtype the client sent, which is
+// what pb.DialOption() sets.
+func NewServer() *Server {
+	return &Server{keys: make(map[string]keyPair)}
+}
+
+// LoadKey decodes the proving/verifying key pair in req and stores it
+// under the sha256 of the proving key bytes, returning that hash as the
+// KeyID clients should pass to Prove/Verify.
+func (s *Server) LoadKey(ctx context.Context, req *pb.LoadKeyRequest) (*pb.LoadKeyResponse, error) {
+	curveID, ok := curveIDFromPB[req.Curve]
+	if !ok {
+		return nil, fmt.Errorf("service: unknown curve %v", req.Curve)
+	}
+
+	cs, err := decodeR1CS(curveID, req.R1cs.Data)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := groth16.ReadProvingKeyFrom(bytes.NewReader(req.ProvingKey.Data))
+	if err != nil {
+		return nil, fmt.Errorf("service: decoding proving key: %w", err)
+	}
+	vk, err := groth16.ReadVerifyingKeyFrom(bytes.NewReader(req.VerifyingKey.Data))
+	if err != nil {
+		return nil, fmt.Errorf("service: decoding verifying key: %w", err)
+	}
+
+	sum := sha256.Sum256(req.ProvingKey.Data)
+	id := hex.EncodeToString(sum[:])
+
+	s.mu.Lock()
+	s.keys[id] = keyPair{cs: cs, pk: pk, vk: vk}
+	s.mu.Unlock()
+
+	return &pb.LoadKeyResponse{KeyId: &pb.KeyID{Value: id}}, nil
+}
+
+// Setup runs groth16.Setup (or DummySetup) on the R1CS in req and keeps
+// the resulting keys server-side under a freshly minted KeyID.
+func (s *Server) Setup(ctx context.Context, req *pb.SetupRequest) (*pb.SetupResponse, error) {
+	curveID, ok := curveIDFromPB[req.Curve]
+	if !ok {
+		return nil, fmt.Errorf("service: unknown curve %v", req.Curve)
+	}
+
+	cs, err := decodeR1CS(curveID, req.R1cs.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var pk groth16.ProvingKey
+	var vk groth16.VerifyingKey
+	if req.Dummy {
+		pk, err = groth16.DummySetup(cs)
+	} else {
+		pk, vk, err = groth16.Setup(cs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("service: setup: %w", err)
+	}
+
+	var pkBuf bytes.Buffer
+	if _, err := groth16.WriteProvingKeyTo(&pkBuf, pk, false); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(pkBuf.Bytes())
+	id := hex.EncodeToString(sum[:])
+
+	s.mu.Lock()
+	s.keys[id] = keyPair{cs: cs, pk: pk, vk: vk}
+	s.mu.Unlock()
+
+	resp := &pb.SetupResponse{KeyId: &pb.KeyID{Value: id}}
+	if vk != nil {
+		var vkBuf bytes.Buffer
+		if _, err := groth16.WriteVerifyingKeyTo(&vkBuf, vk); err != nil {
+			return nil, err
+		}
+		resp.VerifyingKey = &pb.Bytes{Data: vkBuf.Bytes()}
+	}
+	return resp, nil
+}
+
+// Prove runs groth16.Prove against the ProvingKey identified by
+// req.KeyId, using req.Witness as the solution.
+func (s *Server) Prove(ctx context.Context, req *pb.ProveRequest) (*pb.ProveResponse, error) {
+	kp, err := s.lookup(req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := groth16.Prove(kp.cs, kp.pk, witnessToSolution(req.Witness))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if _, err := groth16.WriteProofTo(&buf, proof); err != nil {
+		return nil, err
+	}
+	return &pb.ProveResponse{Proof: &pb.Bytes{Data: buf.Bytes()}}, nil
+}
+
+// Verify runs groth16.Verify against the VerifyingKey identified by
+// req.KeyId.
+func (s *Server) Verify(ctx context.Context, req *pb.VerifyRequest) (*pb.VerifyResponse, error) {
+	kp, err := s.lookup(req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := groth16.ReadProofFrom(bytes.NewReader(req.Proof.Data))
+	if err != nil {
+		return nil, fmt.Errorf("service: decoding proof: %w", err)
+	}
+
+	if err := groth16.Verify(proof, kp.vk, witnessToSolution(req.PublicWitness)); err != nil {
+		return &pb.VerifyResponse{Valid: false, Error: err.Error()}, nil
+	}
+	return &pb.VerifyResponse{Valid: true}, nil
+}
+
+// ProveBatch accepts a stream of witnesses against the same KeyID and
+// sends back proofs as each one finishes, rather than waiting for the
+// whole batch. Witnesses are proved one at a time in the order
+// received, so a slow witness delays the ones behind it. The
+// client signals the end of the batch by closing its send side, which
+// surfaces here as io.EOF; that's the normal, successful end of the
+// stream, not an error.
+func (s *Server) ProveBatch(stream pb.Prover_ProveBatchServer) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.Prove(stream.Context(), req)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) lookup(id *pb.KeyID) (keyPair, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	kp, ok := s.keys[id.GetValue()]
+	if !ok {
+		return keyPair{}, fmt.Errorf("service: unknown key id %q, call LoadKey or Setup first", id.GetValue())
+	}
+	return kp, nil
+}
+
+// decodeR1CS deserializes the curve-specific R1CS shipped alongside a
+// key in LoadKey/Setup requests, mirroring the curve dispatch in
+// groth16.Prove/Verify.
+func decodeR1CS(curveID gurvy.CurveID, data []byte) (r1cs.R1CS, error) {
+	r := bytes.NewReader(data)
+	switch curveID {
+	case gurvy.BN256:
+		cs := &backend_bn256.R1CS{}
+		if _, err := cs.ReadFrom(r); err != nil {
+			return nil, fmt.Errorf("service: decoding r1cs: %w", err)
+		}
+		return cs, nil
+	case gurvy.BLS377:
+		cs := &backend_bls377.R1CS{}
+		if _, err := cs.ReadFrom(r); err != nil {
+			return nil, fmt.Errorf("service: decoding r1cs: %w", err)
+		}
+		return cs, nil
+	case gurvy.BLS381:
+		cs := &backend_bls381.R1CS{}
+		if _, err := cs.ReadFrom(r); err != nil {
+			return nil, fmt.Errorf("service: decoding r1cs: %w", err)
+		}
+		return cs, nil
+	default:
+		return nil, groth16.ErrUnsupportedCurve
+	}
+}
+
+// witnessToSolution converts the wire Witness (string-keyed,
+// string-valued) into the map[string]interface{} expected by
+// groth16.Prove/Verify.
+func witnessToSolution(w *pb.Witness) map[string]interface{} {
+	solution := make(map[string]interface{}, len(w.GetAssignments()))
+	for k, v := range w.GetAssignments() {
+		solution[k] = v
+	}
+	return solution
+}
@@ -0,0 +1,198 @@
+// Package encoding implements the on-disk / on-wire format shared by
+// groth16.ProvingKey, groth16.VerifyingKey and groth16.Proof across all
+// curves supported by gnark.
+//
+// Every serialized object starts with a fixed-size header so that a
+// reader can recover the curve and the point encoding used without any
+// prior knowledge of what it is about to read:
+//
+//	4 bytes  magic      "GNRK"
+//	1 byte   version    format version, currently formatVersion
+//	2 bytes  curveID    gurvy.CurveID, big endian
+//	1 byte   flags      bit 0 set <=> G1/G2 points are compressed
+//
+// The header is followed by the curve-specific payload, written through
+// the WriterTo / ReaderFrom implemented by each curve's ProvingKey,
+// VerifyingKey and Proof types. Unlike io.WriterTo/io.ReaderFrom, those
+// interfaces carry the compressed flag explicitly, since it changes how
+// many bytes each G1/G2 point in the payload takes.
+package encoding
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/consensys/gurvy"
+)
+
+const formatVersion uint8 = 1
+
+var magic = [4]byte{'G', 'N', 'R', 'K'}
+
+// Flags controlling how points are encoded in the payload that follows
+// the header.
+const (
+	FlagCompressed uint8 = 1 << iota
+)
+
+// Header is the fixed-size preamble written before every serialized
+// ProvingKey, VerifyingKey or Proof.
+type Header struct {
+	Version uint8
+	CurveID gurvy.CurveID
+	Flags   uint8
+}
+
+// Compressed reports whether the payload following this header encodes
+// G1/G2 points in compressed form.
+func (h Header) Compressed() bool {
+	return h.Flags&FlagCompressed != 0
+}
+
+// ErrInvalidMagic is returned when the stream being read doesn't start
+// with the expected "GNRK" magic bytes.
+var ErrInvalidMagic = errors.New("encoding: invalid magic number, not a gnark object")
+
+// UnsupportedVersionError is returned when a stream was written with a
+// format version this build of gnark doesn't know how to read.
+type UnsupportedVersionError struct {
+	Version uint8
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("encoding: unsupported format version %d (this build supports up to %d)", e.Version, formatVersion)
+}
+
+// CurveMismatchError is returned when the curve ID found in the header
+// doesn't match the curve of the object passed to Read.
+type CurveMismatchError struct {
+	Expected gurvy.CurveID
+	Got      gurvy.CurveID
+}
+
+func (e *CurveMismatchError) Error() string {
+	return fmt.Sprintf("encoding: curve mismatch, expected %s got %s", e.Expected.String(), e.Got.String())
+}
+
+// WriteHeader writes the versioned header for curveID to w, encoding
+// compressed according to flags.
+func WriteHeader(w io.Writer, curveID gurvy.CurveID, flags uint8) error {
+	var buf [4 + 1 + 2 + 1]byte
+	copy(buf[:4], magic[:])
+	buf[4] = formatVersion
+	binary.BigEndian.PutUint16(buf[5:7], uint16(curveID))
+	buf[7] = flags
+	_, err := w.Write(buf[:])
+	return err
+}
+
+// ReadHeader reads and validates the header at the start of r.
+func ReadHeader(r io.Reader) (Header, error) {
+	var buf [4 + 1 + 2 + 1]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return Header{}, err
+	}
+	if buf[0] != magic[0] || buf[1] != magic[1] || buf[2] != magic[2] || buf[3] != magic[3] {
+		return Header{}, ErrInvalidMagic
+	}
+	version := buf[4]
+	if version > formatVersion {
+		return Header{}, &UnsupportedVersionError{Version: version}
+	}
+	curveID := gurvy.CurveID(binary.BigEndian.Uint16(buf[5:7]))
+	flags := buf[7]
+	return Header{Version: version, CurveID: curveID, Flags: flags}, nil
+}
+
+// PeekCurveID opens path and returns the curve ID announced by its
+// header, without decoding the rest of the file.
+func PeekCurveID(path string) (gurvy.CurveID, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	h, err := ReadHeader(f)
+	if err != nil {
+		return 0, err
+	}
+	return h.CurveID, nil
+}
+
+// WriterTo is implemented by curve-specific ProvingKey, VerifyingKey and
+// Proof types to serialize their payload (everything after the header).
+// Unlike io.WriterTo, WriteTo takes the compressed flag directly: the
+// payload's point encoding depends on it, so it can't be decided by the
+// header alone the way the rest of the format is.
+type WriterTo interface {
+	WriteTo(w io.Writer, compressed bool) (int64, error)
+}
+
+// ReaderFrom is implemented by curve-specific ProvingKey, VerifyingKey
+// and Proof types to deserialize their payload (everything after the
+// header). compressed comes from the header ReadFrom already consumed,
+// so obj knows which point encoding (and therefore how many bytes per
+// point) to expect without needing to re-read anything.
+type ReaderFrom interface {
+	ReadFrom(r io.Reader, compressed bool) (int64, error)
+}
+
+// WriteTo writes a full object (header + payload) for curveID to w.
+// compressed selects whether G1/G2 points in the payload are encoded in
+// compressed form; it is passed through to obj.WriteTo, which is what
+// actually encodes points one way or the other.
+func WriteTo(w io.Writer, curveID gurvy.CurveID, obj WriterTo, compressed bool) (int64, error) {
+	var flags uint8
+	if compressed {
+		flags |= FlagCompressed
+	}
+	if err := WriteHeader(w, curveID, flags); err != nil {
+		return 0, err
+	}
+	n, err := obj.WriteTo(w, compressed)
+	return n + 8, err
+}
+
+// ReadFrom reads the header from r, checks it against curveID, then
+// lets obj decode the remaining payload according to the header's
+// Compressed flag.
+func ReadFrom(r io.Reader, curveID gurvy.CurveID, obj ReaderFrom) (int64, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return 0, err
+	}
+	if h.CurveID != curveID {
+		return 0, &CurveMismatchError{Expected: curveID, Got: h.CurveID}
+	}
+	n, err := obj.ReadFrom(r, h.Compressed())
+	return n + 8, err
+}
+
+// Read opens path and decodes obj (whose payload is expected to match
+// curveID) from it. Kept for callers that still deal in file paths; new
+// code should prefer ReadFrom with an io.Reader.
+func Read(path string, obj ReaderFrom, curveID gurvy.CurveID) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = ReadFrom(f, curveID, obj)
+	return err
+}
+
+// Write creates (or truncates) path and encodes obj to it. Kept for
+// callers that still deal in file paths; new code should prefer WriteTo
+// with an io.Writer.
+func Write(path string, obj WriterTo, curveID gurvy.CurveID, compressed bool) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = WriteTo(f, curveID, obj, compressed)
+	return err
+}
@@ -0,0 +1,102 @@
+// Package recursion demonstrates verifying a BLS12-377 Groth16 proof
+// from inside a BW6-761 circuit. BW6-761 is used as the outer curve
+// specifically because its scalar field is BLS12-377's base field, so
+// the pairing check performed by the verifier can be expressed as
+// native BW6-761 field arithmetic instead of simulating BLS12-377's
+// field inside BW6-761's.
+package recursion
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/backend/bls377"
+	groth16_bls377 "github.com/consensys/gnark/backend/bls377/groth16"
+	groth16_bw6761 "github.com/consensys/gnark/backend/bw6761/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gurvy"
+)
+
+// InnerCircuit is an arbitrary BLS12-377 circuit whose proof the outer
+// circuit below will verify. A real circuit would live wherever the
+// application defines it; it is inlined here only so this package is
+// self-contained.
+type InnerCircuit struct {
+	X frontend.Variable `gnark:",public"`
+	Y frontend.Variable
+}
+
+// Define constrains Y to be the cube of X, i.e. Y == X^3.
+func (c *InnerCircuit) Define(curveID frontend.CurveID, cs *frontend.ConstraintSystem) error {
+	x3 := cs.Mul(c.X, c.X, c.X)
+	cs.AssertIsEqual(x3, c.Y)
+	return nil
+}
+
+// OuterCircuit verifies, inside a BW6-761 circuit, a BLS12-377 Groth16
+// proof of InnerCircuit. Proving OuterCircuit produces a single
+// BW6-761 proof that attests "a valid BLS12-377 proof of InnerCircuit
+// exists", which is how proof recursion/aggregation composes: the
+// outer proof is what actually gets published or further recursed on.
+type OuterCircuit struct {
+	InnerProof groth16_bls377.Proof
+	InnerVK    groth16_bls377.VerifyingKey
+	X          frontend.Variable `gnark:",public"`
+}
+
+// Define asserts that InnerProof verifies against InnerVK for the
+// public input X, using the in-circuit Groth16 verifier for the
+// BLS12-377/BW6-761 pair.
+func (c *OuterCircuit) Define(curveID frontend.CurveID, cs *frontend.ConstraintSystem) error {
+	return groth16_bw6761.VerifyBLS377(cs, c.InnerProof, c.InnerVK, map[string]frontend.Variable{
+		"X": c.X,
+	})
+}
+
+// proveInner runs the full BLS12-377 setup/prove/verify round trip for
+// InnerCircuit and returns the resulting proof and verifying key. Unlike
+// the outer (BW6-761) step, nothing here is a stub: this is a real proof
+// that really verifies, and is the input the outer step would recurse
+// over once it exists.
+func proveInner(x, y int64) (*groth16_bls377.Proof, *groth16_bls377.VerifyingKey, error) {
+	innerCS, err := frontend.Compile(gurvy.BLS377, &InnerCircuit{})
+	if err != nil {
+		return nil, nil, err
+	}
+	innerR1CS := innerCS.(*bls377.R1CS)
+
+	var innerPK groth16_bls377.ProvingKey
+	var innerVK groth16_bls377.VerifyingKey
+	groth16_bls377.Setup(innerR1CS, &innerPK, &innerVK)
+
+	solution := map[string]interface{}{
+		"X": x,
+		"Y": y,
+	}
+	innerProof, err := groth16_bls377.Prove(innerR1CS, &innerPK, solution)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := groth16_bls377.Verify(innerProof, &innerVK, solution); err != nil {
+		return nil, nil, err
+	}
+
+	return innerProof, &innerVK, nil
+}
+
+// SetupAndProve runs the inner BLS12-377 setup/proof/verify in full,
+// then would run the outer BW6-761 setup/proof over a witness asserting
+// that proof verifies, recursing the inner proof into a single BW6-761
+// proof.
+//
+// That outer step can't run yet: OuterCircuit.Define calls
+// groth16_bw6761.VerifyBLS377, which always returns ErrNotImplemented,
+// so compiling OuterCircuit itself fails before there would be anything
+// to set up or prove. Rather than let that surface three calls deep as
+// a confusing frontend.Compile failure, this function stops right after
+// the one part that actually works and reports the real reason directly.
+func SetupAndProve(x, y int64) (*groth16_bw6761.Proof, *groth16_bw6761.VerifyingKey, error) {
+	if _, _, err := proveInner(x, y); err != nil {
+		return nil, nil, err
+	}
+	return nil, nil, fmt.Errorf("recursion: outer (BW6-761) step not implemented yet: %w", groth16_bw6761.ErrNotImplemented)
+}
@@ -0,0 +1,36 @@
+package witness
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// StreamProvider reads a machine-generated witness from a binary
+// stream: one field element per variable, in schema order, each
+// elementSize bytes encoded little-endian. Assign decodes each one
+// into a *big.Int, which groth16.Prove/Verify's solution map already
+// knows how to consume (the same type JSONProvider produces).
+type StreamProvider struct {
+	r           io.Reader
+	elementSize int
+}
+
+// NewStreamProvider wraps r, reading elementSize-byte little-endian
+// field elements from it on every Assign call.
+func NewStreamProvider(r io.Reader, elementSize int) *StreamProvider {
+	return &StreamProvider{r: r, elementSize: elementSize}
+}
+
+// Assign implements Provider.
+func (sp *StreamProvider) Assign(name string, idx int) (interface{}, error) {
+	buf := make([]byte, sp.elementSize)
+	if _, err := io.ReadFull(sp.r, buf); err != nil {
+		return nil, fmt.Errorf("witness: reading element %d (%q): %w", idx, name, err)
+	}
+	// buf is little-endian; big.Int.SetBytes expects big-endian.
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	return new(big.Int).SetBytes(buf), nil
+}
@@ -0,0 +1,62 @@
+package witness
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StructProvider adapts a tagged Go struct to Provider, so callers can
+// assign a witness the same way they would assign a circuit: with
+// `gnark:"..."` struct tags instead of building a map by hand.
+//
+// A field is included if it carries a `gnark` tag. The tag is a
+// comma-separated list of options; `name=foo` overrides the variable
+// name (it otherwise defaults to the Go field name), anything else
+// (e.g. `public`, `secret`) is accepted but currently unused by the
+// provider itself - it is metadata for whatever builds the Schema.
+type StructProvider struct {
+	byName map[string]reflect.Value
+}
+
+// NewStructProvider builds a StructProvider over witness, which must be
+// a struct or a pointer to one.
+func NewStructProvider(witness interface{}) (*StructProvider, error) {
+	v := reflect.ValueOf(witness)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("witness: NewStructProvider expects a struct, got %s", v.Kind())
+	}
+
+	sp := &StructProvider{byName: make(map[string]reflect.Value)}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup("gnark")
+		if !ok {
+			continue
+		}
+		name := field.Name
+		for _, opt := range strings.Split(tag, ",") {
+			if n := strings.TrimPrefix(opt, "name="); n != opt {
+				name = n
+			}
+		}
+		sp.byName[name] = v.Field(i)
+	}
+	return sp, nil
+}
+
+// Assign implements Provider.
+func (sp *StructProvider) Assign(name string, idx int) (interface{}, error) {
+	f, ok := sp.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("witness: no struct field tagged for variable %q", name)
+	}
+	if !f.CanInterface() {
+		return nil, fmt.Errorf("witness: field tagged for variable %q is unexported", name)
+	}
+	return f.Interface(), nil
+}
@@ -0,0 +1,67 @@
+// Package witness lets callers hand gnark's Prove/Verify a witness
+// without first marshaling their application data into a
+// map[string]interface{} by hand. Provider is the pull-based
+// counterpart to that map: Prove/Verify ask for one value at a time, in
+// the order the R1CS declared its variables, and an implementation
+// decides how to produce it (a struct via reflection, a binary stream,
+// a JSON document, ...).
+package witness
+
+import "fmt"
+
+// Schema describes the variables an R1CS expects to be assigned, in
+// the order Provider.Assign's idx refers to. Concrete R1CS types
+// implement it so ProveWithWitness/VerifyWithWitness can drive a
+// Provider without asking the caller to repeat the variable list.
+type Schema interface {
+	// Variables returns the declared witness variable names, in
+	// assignment order.
+	Variables() []string
+}
+
+// Provider supplies the value assigned to a named witness variable.
+// name is the variable's declared name; idx is its position in
+// Schema.Variables(), given for adapters that key on position rather
+// than name (e.g. StreamProvider).
+//
+// Assign returns interface{}, not a curve-specific fr.Element: this
+// package doesn't import bn256/bls377/bls381's fr packages at all, so
+// it can stay the one adapter layer all three curve backends share
+// instead of needing one Provider per curve. The values it returns are
+// exactly what groth16.Prove/Verify's map[string]interface{} solution
+// already accepts (numbers, numeric strings, *big.Int); ToMap just
+// hands them through unconverted. An adapter that decodes field
+// elements from bytes (StreamProvider) or hex (JSONProvider) returns a
+// *big.Int for that reason, not a raw byte value.
+type Provider interface {
+	Assign(name string, idx int) (interface{}, error)
+}
+
+// ToMap drains p against schema into the map[string]interface{} shape
+// the existing groth16 backends accept, so all three curves can share
+// one code path until they consume a Provider directly.
+func ToMap(p Provider, schema Schema) (map[string]interface{}, error) {
+	vars := schema.Variables()
+	solution := make(map[string]interface{}, len(vars))
+	for idx, name := range vars {
+		v, err := p.Assign(name, idx)
+		if err != nil {
+			return nil, fmt.Errorf("witness: assigning %q: %w", name, err)
+		}
+		solution[name] = v
+	}
+	return solution, nil
+}
+
+// MapProvider adapts the existing map[string]interface{} solution form
+// to Provider.
+type MapProvider map[string]interface{}
+
+// Assign implements Provider.
+func (m MapProvider) Assign(name string, idx int) (interface{}, error) {
+	v, ok := m[name]
+	if !ok {
+		return nil, fmt.Errorf("witness: no value for %q", name)
+	}
+	return v, nil
+}